@@ -64,6 +64,60 @@ func (c *Client) GetActorCredits(actorID int, language string) (*models.ActorCre
 	return &response, nil
 }
 
+// GetCombinedCredits fetches a person's movie and tv credits
+func (c *Client) GetCombinedCredits(personID int, language string) (*models.ActorCreditsResponse, error) {
+	apiPath := fmt.Sprintf("/person/%d/combined_credits", personID)
+	params := url.Values{}
+	params.Set("language", language)
+
+	req, err := c.createRequest(apiPath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ActorCreditsResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetPersonDetails fetches a person's details. Biographies are often only written
+// in English, so an empty biography falls back to the English one.
+func (c *Client) GetPersonDetails(personID int, language string) (*models.PersonDetails, error) {
+	person, err := c.getPersonDetails(personID, language)
+	if err != nil {
+		return nil, err
+	}
+
+	if person.Biography == "" && language != "en-US" {
+		if english, err := c.getPersonDetails(personID, "en-US"); err == nil {
+			person.Biography = english.Biography
+		}
+	}
+
+	return person, nil
+}
+
+func (c *Client) getPersonDetails(personID int, language string) (*models.PersonDetails, error) {
+	apiPath := fmt.Sprintf("/person/%d", personID)
+	params := url.Values{}
+	params.Set("language", language)
+
+	req, err := c.createRequest(apiPath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.PersonDetails
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 func (c *Client) GetPopularActors(language string, page int) (*models.ActorSearchResponse, error) {
 	params := url.Values{}
 	params.Set("language", language)
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 	"bytes"
 
@@ -18,6 +19,16 @@ type Client struct {
 	apiKey     string
 	httpClient *http.Client
 	timeout    time.Duration
+	// cache holds response bodies of static endpoints by URL, nil unless EnableCache was called
+	cache map[string][]byte
+}
+
+// staticPaths are the endpoints cached by EnableCache: lists that don't change
+// during a session, unlike ratings, availability or search results
+var staticPaths = map[string]bool{
+	"/genre/movie/list":      true,
+	"/genre/tv/list":         true,
+	"/watch/providers/movie": true,
 }
 
 func NewClient(apiKey string, timeout int) (*Client, error) {
@@ -32,6 +43,14 @@ func NewClient(apiKey string, timeout int) (*Client, error) {
 	}, nil
 }
 
+// EnableCache keeps successful responses of static endpoints (genre and provider
+// lists) in memory, so an interactive shell only requests them once
+func (c *Client) EnableCache() {
+	if c.cache == nil {
+		c.cache = make(map[string][]byte)
+	}
+}
+
 func (c *Client) createRequest(apiPath string, params url.Values) (*http.Request, error) {
 	params.Set("api_key", c.apiKey)
 	fullURL := fmt.Sprintf("%s%s?%s", baseURL, apiPath, params.Encode())
@@ -48,6 +67,11 @@ func (c *Client) createRequest(apiPath string, params url.Values) (*http.Request
 func (c *Client) doRequest(req *http.Request, target interface{}) error {
     cfg := config.Get()
 
+    cacheable := c.cache != nil && staticPaths[strings.TrimPrefix(req.URL.Path, "/3")]
+    if cached, ok := c.cache[req.URL.String()]; ok && cacheable {
+        return json.Unmarshal(cached, target)
+    }
+
     resp, err := c.httpClient.Do(req)
     if err != nil {
         return fmt.Errorf("request failed: %w", err)
@@ -98,5 +122,9 @@ func (c *Client) doRequest(req *http.Request, target interface{}) error {
         return fmt.Errorf("failed to decode response: %w", err)
     }
 
+    if cacheable {
+        c.cache[req.URL.String()] = bodyBytes
+    }
+
     return nil
 }
\ No newline at end of file
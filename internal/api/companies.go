@@ -0,0 +1,64 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// SearchCompany searches companies and collects up to config.MaxPagesToSearch pages of
+// results, so an exact match of a common name is found on a later page as well
+func (c *Client) SearchCompany(query string) (*models.CompanySearchResponse, error) {
+	params := url.Values{}
+	params.Set("query", query)
+
+	req, err := c.createRequest("/search/company", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.CompanySearchResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	maxPages := config.MaxPagesToSearch
+	if response.TotalPages < maxPages {
+		maxPages = response.TotalPages
+	}
+
+	for page := 2; page <= maxPages; page++ {
+		params.Set("page", strconv.Itoa(page))
+		req, err := c.createRequest("/search/company", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageResponse models.CompanySearchResponse
+		if err := c.doRequest(req, &pageResponse); err != nil {
+			return nil, err
+		}
+
+		response.Results = append(response.Results, pageResponse.Results...)
+	}
+
+	return &response, nil
+}
+
+func (c *Client) GetNetwork(networkID int) (*models.Network, error) {
+	apiPath := fmt.Sprintf("/network/%d", networkID)
+	req, err := c.createRequest(apiPath, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var network models.Network
+	if err := c.doRequest(req, &network); err != nil {
+		return nil, err
+	}
+
+	return &network, nil
+}
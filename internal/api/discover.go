@@ -0,0 +1,143 @@
+package api
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// DiscoverParams holds the filters that can be pushed down to TMDB's discover endpoints.
+// Empty fields are not sent.
+type DiscoverParams struct {
+	SortBy   string
+	Region   string
+	MinVotes int
+	// MaxVotes caps the vote count, e.g. to find lesser-known titles
+	MaxVotes int
+	// DateFrom and DateTo (YYYY-MM-DD) filter on the primary release date for
+	// movies and the first air date for shows
+	DateFrom string
+	DateTo   string
+	// RuntimeMin and RuntimeMax are in minutes
+	RuntimeMin int
+	RuntimeMax int
+	// WithGenres and WithoutGenres are genre ID lists in discover syntax (',' = AND, '|' = OR)
+	WithGenres    string
+	WithoutGenres string
+	// WithKeywords and WithoutKeywords are keyword ID lists in discover syntax
+	WithKeywords    string
+	WithoutKeywords string
+	// WithCompanies and WithNetworks are ID lists in discover syntax; networks only apply to shows
+	WithCompanies string
+	WithNetworks  string
+	// OriginalLanguages (ISO 639-1) and OriginCountries (ISO 3166-1) match any of the codes
+	OriginalLanguages []string
+	OriginCountries   []string
+	// CertificationCountry and MaxCertification filter movies by age rating, e.g. DE and 12
+	CertificationCountry string
+	MaxCertification     string
+	// WithWatchProviders is a provider ID list in discover syntax, matched against the
+	// flatrate offers in WatchRegion
+	WithWatchProviders string
+	WatchRegion        string
+}
+
+func (p DiscoverParams) values(dateField string) url.Values {
+	params := url.Values{}
+	if p.SortBy != "" {
+		params.Set("sort_by", p.SortBy)
+	}
+	if p.Region != "" {
+		params.Set("region", p.Region)
+	}
+	if p.MinVotes > 0 {
+		params.Set("vote_count.gte", strconv.Itoa(p.MinVotes))
+	}
+	if p.MaxVotes > 0 {
+		params.Set("vote_count.lte", strconv.Itoa(p.MaxVotes))
+	}
+	if p.DateFrom != "" {
+		params.Set(dateField+".gte", p.DateFrom)
+	}
+	if p.DateTo != "" {
+		params.Set(dateField+".lte", p.DateTo)
+	}
+	if p.RuntimeMin > 0 {
+		params.Set("with_runtime.gte", strconv.Itoa(p.RuntimeMin))
+	}
+	if p.RuntimeMax > 0 {
+		params.Set("with_runtime.lte", strconv.Itoa(p.RuntimeMax))
+	}
+	if p.WithGenres != "" {
+		params.Set("with_genres", p.WithGenres)
+	}
+	if p.WithoutGenres != "" {
+		params.Set("without_genres", p.WithoutGenres)
+	}
+	if p.WithKeywords != "" {
+		params.Set("with_keywords", p.WithKeywords)
+	}
+	if p.WithoutKeywords != "" {
+		params.Set("without_keywords", p.WithoutKeywords)
+	}
+	if p.WithCompanies != "" {
+		params.Set("with_companies", p.WithCompanies)
+	}
+	if len(p.OriginalLanguages) > 0 {
+		params.Set("with_original_language", strings.Join(p.OriginalLanguages, "|"))
+	}
+	if len(p.OriginCountries) > 0 {
+		params.Set("with_origin_country", strings.Join(p.OriginCountries, "|"))
+	}
+	if p.WithWatchProviders != "" && p.WatchRegion != "" {
+		params.Set("with_watch_providers", p.WithWatchProviders)
+		params.Set("watch_region", p.WatchRegion)
+		params.Set("with_watch_monetization_types", "flatrate")
+	}
+	return params
+}
+
+func (c *Client) DiscoverMovies(page int, language string, discover DiscoverParams) (*models.DiscoverResponse, error) {
+	params := discover.values("primary_release_date")
+	if discover.CertificationCountry != "" && discover.MaxCertification != "" {
+		params.Set("certification_country", discover.CertificationCountry)
+		params.Set("certification.lte", discover.MaxCertification)
+	}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("language", language)
+
+	req, err := c.createRequest("/discover/movie", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.DiscoverResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) DiscoverShows(page int, language string, discover DiscoverParams) (*models.ShowDiscoverResponse, error) {
+	params := discover.values("first_air_date")
+	if discover.WithNetworks != "" {
+		params.Set("with_networks", discover.WithNetworks)
+	}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("language", language)
+
+	req, err := c.createRequest("/discover/tv", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ShowDiscoverResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
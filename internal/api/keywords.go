@@ -0,0 +1,79 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// SearchKeyword searches keywords and collects up to config.MaxPagesToSearch pages of
+// results, so an exact match of a common term is found on a later page as well
+func (c *Client) SearchKeyword(query string) (*models.KeywordSearchResponse, error) {
+	params := url.Values{}
+	params.Set("query", query)
+
+	req, err := c.createRequest("/search/keyword", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.KeywordSearchResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	maxPages := config.MaxPagesToSearch
+	if response.TotalPages < maxPages {
+		maxPages = response.TotalPages
+	}
+
+	for page := 2; page <= maxPages; page++ {
+		params.Set("page", strconv.Itoa(page))
+		req, err := c.createRequest("/search/keyword", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageResponse models.KeywordSearchResponse
+		if err := c.doRequest(req, &pageResponse); err != nil {
+			return nil, err
+		}
+
+		response.Results = append(response.Results, pageResponse.Results...)
+	}
+
+	return &response, nil
+}
+
+func (c *Client) GetMovieKeywords(movieID int) ([]models.Keyword, error) {
+	apiPath := fmt.Sprintf("/movie/%d/keywords", movieID)
+	req, err := c.createRequest(apiPath, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.MovieKeywordsResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Keywords, nil
+}
+
+func (c *Client) GetShowKeywords(showID int) ([]models.Keyword, error) {
+	apiPath := fmt.Sprintf("/tv/%d/keywords", showID)
+	req, err := c.createRequest(apiPath, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ShowKeywordsResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Results, nil
+}
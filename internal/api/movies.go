@@ -44,6 +44,78 @@ func (c *Client) GetPopularMovies(page int, language string) (*models.DiscoverRe
 	return &response, nil
 }
 
+// GetTrending fetches this week's trending movies, shows and people; results carry a media type
+func (c *Client) GetTrending(page int, language string) (*models.DiscoverResponse, error) {
+	params := url.Values{}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("language", language)
+
+	req, err := c.createRequest("/trending/all/week", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.DiscoverResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) GetUpcomingMovies(page int, language string, region string) (*models.DiscoverResponse, error) {
+	params := url.Values{}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("language", language)
+	params.Set("region", region)
+
+	req, err := c.createRequest("/movie/upcoming", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.DiscoverResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) GetNowPlayingMovies(page int, language string, region string) (*models.DiscoverResponse, error) {
+	params := url.Values{}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("language", language)
+	params.Set("region", region)
+
+	req, err := c.createRequest("/movie/now_playing", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.DiscoverResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) GetReleaseDates(movieID int) (*models.ReleaseDatesResponse, error) {
+	apiPath := fmt.Sprintf("/movie/%d/release_dates", movieID)
+	req, err := c.createRequest(apiPath, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ReleaseDatesResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 func (c *Client) GetWatchProviders(movieID int, region string) (models.RegionProviders, error) {
 	apiPath := fmt.Sprintf("/movie/%d/watch/providers", movieID)
 	req, err := c.createRequest(apiPath, url.Values{})
@@ -63,6 +135,25 @@ func (c *Client) GetWatchProviders(movieID int, region string) (models.RegionPro
 	return models.RegionProviders{}, fmt.Errorf("no provider data for region %s", region)
 }
 
+// GetMovieProviderList returns the movie watch providers of a region, e.g. to look up
+// provider IDs for discover
+func (c *Client) GetMovieProviderList(region string) ([]models.Provider, error) {
+	params := url.Values{}
+	params.Set("watch_region", region)
+
+	req, err := c.createRequest("/watch/providers/movie", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ProviderListResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Results, nil
+}
+
 func (c *Client) GetExternalIDs(movieID int) (models.ExternalIDs, error) {
 	apiPath := fmt.Sprintf("/movie/%d/external_ids", movieID)
 	req, err := c.createRequest(apiPath, url.Values{})
@@ -100,11 +191,11 @@ func (c *Client) GetEnglishTitle(movieID int) (string, error) {
 	return c.GetRegionalTitle(movieID, "en-US")
 }
 
-func (c *Client) SearchMovie(query string, language string, region string) (*models.DiscoverResponse, error) {
+func (c *Client) SearchMovie(query string, language string, region string, includeAdult bool) (*models.DiscoverResponse, error) {
 	params := url.Values{}
 	params.Set("query", query)
 	params.Set("language", language)
-	params.Set("include_adult", "true")
+	params.Set("include_adult", strconv.FormatBool(includeAdult))
 	params.Set("region", region)
 
 	// initialize the response with the first page
@@ -169,6 +260,23 @@ func (c *Client) GetGenres(language string) (*models.GenreListResponse, error) {
 	return &response, nil
 }
 
+func (c *Client) GetTVGenres(language string) (*models.GenreListResponse, error) {
+	params := url.Values{}
+	params.Set("language", language)
+
+	req, err := c.createRequest("/genre/tv/list", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.GenreListResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 func (c *Client) GetMovieDetails(movieID int, language string) (*models.Movie, error) {
 	apiPath := fmt.Sprintf("/movie/%d", movieID)
 	params := url.Values{}
@@ -186,3 +294,22 @@ func (c *Client) GetMovieDetails(movieID int, language string) (*models.Movie, e
 
 	return &movie, nil
 }
+
+// GetMovieCredits fetches the cast and crew of a movie
+func (c *Client) GetMovieCredits(movieID int, language string) (*models.MovieCreditsResponse, error) {
+	apiPath := fmt.Sprintf("/movie/%d/credits", movieID)
+	params := url.Values{}
+	params.Set("language", language)
+
+	req, err := c.createRequest(apiPath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.MovieCreditsResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// SearchMulti searches movies, shows and people at once and collects up to
+// config.MaxPagesToSearch pages of results
+func (c *Client) SearchMulti(query string, language string, includeAdult bool) (*models.MultiSearchResponse, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("language", language)
+	params.Set("include_adult", strconv.FormatBool(includeAdult))
+
+	req, err := c.createRequest("/search/multi", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.MultiSearchResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	maxPages := config.MaxPagesToSearch
+	if response.TotalPages < maxPages {
+		maxPages = response.TotalPages
+	}
+
+	for page := 2; page <= maxPages; page++ {
+		params.Set("page", strconv.Itoa(page))
+		req, err := c.createRequest("/search/multi", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageResponse models.MultiSearchResponse
+		if err := c.doRequest(req, &pageResponse); err != nil {
+			return nil, err
+		}
+
+		response.Results = append(response.Results, pageResponse.Results...)
+	}
+
+	return &response, nil
+}
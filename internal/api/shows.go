@@ -26,6 +26,42 @@ func (c *Client) GetTopRatedShows(page int, language string) (*models.ShowDiscov
 	return &response, nil
 }
 
+func (c *Client) GetAiringTodayShows(page int, language string) (*models.ShowDiscoverResponse, error) {
+	params := url.Values{}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("language", language)
+
+	req, err := c.createRequest("/tv/airing_today", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ShowDiscoverResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) GetOnTheAirShows(page int, language string) (*models.ShowDiscoverResponse, error) {
+	params := url.Values{}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("language", language)
+
+	req, err := c.createRequest("/tv/on_the_air", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ShowDiscoverResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 func (c *Client) GetShowWatchProviders(showID int, region string) (models.RegionProviders, error) {
 	apiPath := fmt.Sprintf("/tv/%d/watch/providers", showID)
 	req, err := c.createRequest(apiPath, url.Values{})
@@ -76,4 +112,56 @@ func (c *Client) GetShowEnglishTitle(showID int) (string, error) {
 	}
 
 	return response.Name, nil
-}
\ No newline at end of file
+}
+
+func (c *Client) GetShowDetails(showID int, language string) (*models.ShowDetails, error) {
+	apiPath := fmt.Sprintf("/tv/%d", showID)
+	params := url.Values{}
+	params.Set("language", language)
+
+	req, err := c.createRequest(apiPath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ShowDetails
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetShow fetches a show's details decoded as a list entry, e.g. to display a single show
+func (c *Client) GetShow(showID int, language string) (*models.Show, error) {
+	apiPath := fmt.Sprintf("/tv/%d", showID)
+	params := url.Values{}
+	params.Set("language", language)
+
+	req, err := c.createRequest(apiPath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.Show
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) GetContentRatings(showID int) (*models.ContentRatingsResponse, error) {
+	apiPath := fmt.Sprintf("/tv/%d/content_ratings", showID)
+	req, err := c.createRequest(apiPath, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ContentRatingsResponse
+	if err := c.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -5,52 +5,67 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/sebastianneubert/tmdb/internal/api"
 	"github.com/sebastianneubert/tmdb/internal/config"
 	"github.com/sebastianneubert/tmdb/internal/display"
 	"github.com/sebastianneubert/tmdb/internal/filters"
 	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/processor"
+	"github.com/sebastianneubert/tmdb/internal/tui"
 	"github.com/spf13/cobra"
 )
 
+var actorFlags = MovieCommandFlags{}
+
+// maxActorMatches is the number of search results listed to choose from
+const maxActorMatches = 15
+
 var (
-	actorProviders string
-	actorRegion    string
-	actorMinRating float64
-	actorMinVotes  int
-	actorTimeout   int
-	actorGenre     string
-	actorList      bool
+	actorList     bool
+	actorRole     string
+	actorMedia    string
+	actorTimeline bool
 )
 
 var actorCmd = &cobra.Command{
-	Use:   "actor [name] [index]",
-	Short: "Find an actor's filmography with streaming availability.",
-	Args:  cobra.MaximumNArgs(2),
-	Run:   runActor,
+	Use:     "actor [name] [index]",
+	Aliases: []string{"person"},
+	Short:   "Find an actor's (or director's, writer's, composer's) filmography with streaming availability.",
+	Args:    cobra.MaximumNArgs(2),
+	Run:     runActor,
 	Long: `Search for an actor and display their movies with ratings and availability.
 If no name is provided with --list flag, shows popular actors.
-If search results have multiple matches, displays a list to choose from.
+If search results have multiple matches, a picker opens in a terminal (arrow
+keys or number, enter to select); otherwise a list to choose from is displayed.
 You can specify an index to select a specific actor from multiple results.
 Movies are displayed with genres and filtered by region-specific titles.
+Movies and TV shows are listed by default, use --media to pick one.
+Use --role to list crew credits instead, with the job shown for each title.
+With --role any, films the person both acted in and directed are listed once.
 
 Examples:
   tmdb actor
   tmdb actor "Leonardo DiCaprio"
   tmdb actor "Tom" 1
   tmdb actor "Megan Fox" 2
-  tmdb actor "Tom Hanks" --genre Action`,
+  tmdb actor "Tom Hanks" --genre Action
+  tmdb person "Christopher Nolan" --role director
+  tmdb person "Hans Zimmer" --role composer
+  tmdb actor "Bryan Cranston" --media tv
+  tmdb actor "Meryl Streep" --timeline`,
 }
 
 func init() {
-	actorCmd.Flags().StringVarP(&actorProviders, "providers", "p", config.DefaultProviders, "Comma-separated providers")
-	actorCmd.Flags().StringVarP(&actorRegion, "region", "r", config.DefaultRegion, "Watch region")
-	actorCmd.Flags().Float64Var(&actorMinRating, "min-rating", config.DefaultMinRating, "Minimum rating")
-	actorCmd.Flags().IntVar(&actorMinVotes, "min-votes", config.DefaultMinVotes, "Minimum votes")
-	actorCmd.Flags().IntVarP(&actorTimeout, "timeout", "T", config.DefaultTimeout, "Timeout in seconds")
-	actorCmd.Flags().StringVar(&actorGenre, "genre", "", "Filter by genre (name or ID)")
+	actorFlags.Register(actorCmd, true)
+	actorFlags.RegisterSeen(actorCmd)
+	actorFlags.RegisterFits(actorCmd)
+	actorFlags.RegisterSort(actorCmd)
 	actorCmd.Flags().BoolVar(&actorList, "list", false, "List actors instead of fetching filmography")
+	actorCmd.Flags().StringVar(&actorRole, "role", "actor", "Credits to list: "+strings.Join(filters.Roles, ", "))
+	actorCmd.Flags().BoolVar(&actorTimeline, "timeline", false, "Show a chart of the average rating per year instead of the movie list")
+	actorCmd.Flags().StringVar(&actorMedia, "media", "all", "Titles to list: "+strings.Join(filters.Media, ", "))
 }
 
 func runActor(cmd *cobra.Command, args []string) {
@@ -74,47 +89,31 @@ func runActor(cmd *cobra.Command, args []string) {
 		actorIndex--
 	}
 
-	finalRegion := cfg.Region
-	if cmd.Flags().Changed("region") {
-		finalRegion = actorRegion
-	}
-
-	finalProviders := cfg.Providers
-	if cmd.Flags().Changed("providers") {
-		finalProviders = actorProviders
-	}
-
-	finalMinRating := cfg.MinRating
-	if cmd.Flags().Changed("min-rating") {
-		finalMinRating = actorMinRating
-	}
+	finalRegion, finalProviders, _, _, finalTimeout, _ := actorFlags.Resolve(cmd, cfg)
 
-	finalMinVotes := cfg.MinVotes
-	if cmd.Flags().Changed("min-votes") {
-		finalMinVotes = actorMinVotes
+	role, err := filters.ParseRole(actorRole)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
 	}
-
-	finalTimeout := cfg.Timeout
-	if cmd.Flags().Changed("timeout") {
-		finalTimeout = actorTimeout
+	media, err := filters.ParseMedia(actorMedia)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
 	}
 
-	client, err := api.NewClient(cfg.APIKey, finalTimeout)
+	client, err := newClient(cfg.APIKey, finalTimeout)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		return
 	}
 
-	desiredProviders := filters.ParseProviders(finalProviders)
+	genreList, genreMap := LoadGenres(client)
 
-	// Fetch genres for filtering
-	var genreList []models.Genre
-	var genreMap map[string]int
-
-	genreResp, err := client.GetGenres(finalRegion)
-	if err == nil {
-		genreList = genreResp.Genres
-		genreMap = filters.BuildGenreMap(genreList)
+	filterConfig, err := actorFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
 	}
 
 	// If --list flag is set and no actor name provided, show popular actors
@@ -123,6 +122,14 @@ func runActor(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	filmography, err := newFilmography(cmd, cfg, client, &actorFlags, filterConfig, role, media)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	filmography.Providers = finalProviders
+	filmography.Timeline = actorTimeline
+
 	fmt.Printf("Searching for actor: %s\n\n", actorName)
 
 	actorResults, err := client.SearchActor(actorName, finalRegion)
@@ -136,41 +143,93 @@ func runActor(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	actor, ok := selectActor(actorResults.Results, actorName, actorIndex,
+		fmt.Sprintf("To view filmography, use:\n  tmdb actor \"%s\" 1", actorName))
+	if !ok {
+		return
+	}
+	displayActorFilmography(client, actor, filmography)
+}
+
+// selectActor picks the actor at index (0-based, -1 if none was given) from the search
+// results sorted by popularity. Without an index a single match is picked, several matches
+// are listed followed by the hint on how to pick one.
+func selectActor(results []models.Actor, name string, index int, hint string) (models.Actor, bool) {
 	// Sort all results by popularity (descending) so index matches displayed order
-	sort.Slice(actorResults.Results, func(i, j int) bool {
-		return actorResults.Results[i].Popularity > actorResults.Results[j].Popularity
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Popularity > results[j].Popularity
 	})
 
 	// If actor index is provided, use it to select from sorted results
-	if actorIndex >= 0 {
-		if actorIndex >= len(actorResults.Results) {
+	if index >= 0 {
+		if index >= len(results) {
 			fmt.Printf("Invalid actor index: %d. Found only %d actors matching '%s' (use 1-%d)\n",
-				actorIndex+1, len(actorResults.Results), actorName, len(actorResults.Results))
-			displayActorMatches(actorResults.Results)
-			return
+				index+1, len(results), name, len(results))
+			displayActorMatches(results)
+			return models.Actor{}, false
 		}
-		actor := actorResults.Results[actorIndex]
-		displayActorFilmography(client, actor, finalRegion, finalProviders, finalMinRating, finalMinVotes, desiredProviders, actorGenre, genreList, genreMap)
-		return
+		return results[index], true
 	}
 
-	// If multiple results and --list flag is set, show the list
-	if len(actorResults.Results) > 1 && actorList {
-		displayActorMatches(actorResults.Results)
-		return
+	// On a terminal, pick one of multiple results right away
+	if len(results) > 1 && tui.IsInteractive() {
+		if actor, picked, err := pickActor(results, name); err == nil {
+			return actor, picked
+		}
 	}
 
-	// If multiple results and no --list flag, show matches prompt
-	if len(actorResults.Results) > 1 {
-		fmt.Printf("Found %d actors matching '%s'. Did you mean one of these?\n\n", len(actorResults.Results), actorName)
-		displayActorMatches(actorResults.Results)
-		fmt.Printf("\nTo view filmography, use:\n  tmdb actor \"%s\" 1\n\n", actorName)
-		return
+	// If multiple results, show matches prompt
+	if len(results) > 1 {
+		fmt.Printf("Found %d actors matching '%s'. Did you mean one of these?\n\n", len(results), name)
+		displayActorMatches(results)
+		fmt.Printf("\n%s\n\n", hint)
+		return models.Actor{}, false
 	}
 
 	// Proceed with single match
-	actor := actorResults.Results[0]
-	displayActorFilmography(client, actor, finalRegion, finalProviders, finalMinRating, finalMinVotes, desiredProviders, actorGenre, genreList, genreMap)
+	return results[0], true
+}
+
+// pickActor lets the user choose one of the (sorted) search results interactively
+func pickActor(results []models.Actor, name string) (models.Actor, bool, error) {
+	candidates := results
+	if len(candidates) > maxActorMatches {
+		candidates = candidates[:maxActorMatches]
+	}
+
+	items := make([]tui.Item, len(candidates))
+	for i, actor := range candidates {
+		items[i] = tui.Item{
+			Title:  fmt.Sprintf("%s (popularity %.1f)", actor.Name, actor.Popularity),
+			Detail: knownForSummary(actor),
+		}
+	}
+
+	picker := &tui.Picker{
+		Prompt: fmt.Sprintf("Found %d actors matching '%s'. Which one?", len(results), name),
+		Items:  items,
+	}
+	index, picked, err := tui.Pick(picker)
+	if err != nil || !picked {
+		return models.Actor{}, false, err
+	}
+	return candidates[index], true, nil
+}
+
+// knownForSummary describes an actor as "Acting: Forrest Gump, Cast Away"
+func knownForSummary(actor models.Actor) string {
+	titles := make([]string, 0, len(actor.KnownFor))
+	for _, movie := range actor.KnownFor {
+		titles = append(titles, movie.GetTitle())
+	}
+	department := actor.KnownForDepartment
+	if department == "" {
+		return strings.Join(titles, ", ")
+	}
+	if len(titles) == 0 {
+		return department
+	}
+	return department + ": " + strings.Join(titles, ", ")
 }
 
 func displayActorMatches(actors []models.Actor) {
@@ -178,16 +237,16 @@ func displayActorMatches(actors []models.Actor) {
 
 	// Display top actors (already sorted by popularity)
 	displayCount := 0
-	maxDisplay := 15
 
-	for i := 0; i < len(actors) && displayCount < maxDisplay; i++ {
+	for i := 0; i < len(actors) && displayCount < maxActorMatches; i++ {
 		actor := actors[i]
 		displayCount++
 		display.DisplayActor(display.ActorDisplay{
-			Number:     displayCount,
-			Name:       actor.Name,
-			Popularity: actor.Popularity,
-			TmdbID:     actor.ID,
+			Number:      displayCount,
+			Name:        actor.Name,
+			Popularity:  actor.Popularity,
+			TmdbID:      actor.ID,
+			ProfilePath: actor.ProfilePath,
 		})
 	}
 	display.DisplaySeparator()
@@ -224,39 +283,170 @@ func displayPopularActors(client *api.Client, language string) {
 		actor := sortedActors[i]
 		displayCount++
 		display.DisplayActor(display.ActorDisplay{
-			Number:     displayCount,
-			Name:       actor.Name,
-			Popularity: actor.Popularity,
-			TmdbID:     actor.ID,
+			Number:      displayCount,
+			Name:        actor.Name,
+			Popularity:  actor.Popularity,
+			TmdbID:      actor.ID,
+			ProfilePath: actor.ProfilePath,
 		})
 	}
 	display.DisplaySeparator()
 	fmt.Printf("Showing top %d popular actors\n", displayCount)
 }
 
-func displayActorFilmography(client *api.Client, actor models.Actor, finalRegion, finalProviders string, finalMinRating float64, finalMinVotes int, desiredProviders map[string]bool, genreFilter string, genreList []models.Genre, genreMap map[string]int) {
+// filmography holds what displayActorFilmography lists: the role and media of the
+// credits, and the filters for movies and shows (shows use TV genre IDs)
+type filmography struct {
+	Role  string
+	Media string
+	// Timeline charts the movies instead of listing them
+	Timeline  bool
+	Movies    processor.FilterConfig
+	Shows     processor.FilterConfig
+	Providers string
+}
+
+// newFilmography builds the movie and show filters for a person's filmography
+func newFilmography(cmd *cobra.Command, cfg config.Config, client *api.Client, flags *MovieCommandFlags, movieConfig processor.FilterConfig, role, media string) (filmography, error) {
+	f := filmography{Role: role, Media: media, Movies: movieConfig, Shows: movieConfig}
+	if media != "movie" {
+		// Keywords, companies and networks are already resolved, only the genres differ for TV
+		tvGenreList, tvGenreMap := LoadTVGenres(client)
+		_, _, _, _, _, genre := flags.Resolve(cmd, cfg)
+		genres, err := filters.ParseGenreFilter(genre, flags.ExcludeGenre, tvGenreMap)
+		if err != nil {
+			return f, err
+		}
+		f.Shows.Genres = genres
+		f.Shows.GenreList = tvGenreList
+		f.Shows.GenreMap = tvGenreMap
+	}
+	return f, nil
+}
+
+func displayActorFilmography(client *api.Client, actor models.Actor, f filmography) {
+	filterConfig := f.Movies
+	finalRegion := filterConfig.Region
+
 	fmt.Printf("Found: %s (TMDb ID: %d)\n", display.TitleStyle.Render(actor.Name), actor.ID)
 	fmt.Printf("Fetching filmography...\n\n")
 
-	credits, err := client.GetActorCredits(actor.ID, finalRegion)
+	credits, err := client.GetCombinedCredits(actor.ID, finalRegion)
 	if err != nil {
 		fmt.Printf("Error fetching filmography: %v\n", err)
 		return
 	}
 
-	if len(credits.Cast) == 0 {
-		fmt.Printf("No movie credits found.\n")
+	movies, shows := filters.SplitCredits(filters.CreditsForRole(credits, f.Role), f.Media)
+
+	displayPersonDetails(client, actor.ID, finalRegion, movies, shows)
+	if len(movies) == 0 && len(shows) == 0 {
+		fmt.Printf("No credits found as %s.\n", f.Role)
+		return
+	}
+
+	if f.Timeline {
+		displayCareerTimeline(client, actor.Name, movies, filterConfig)
+		return
+	}
+
+	fmt.Printf("Filtering with Min Rating: %.1f | Min Votes: %d\n", filterConfig.MinRating, filterConfig.MinVotes)
+	fmt.Printf("Checking [%s] in region [%s]\n\n", f.Providers, strings.ToUpper(finalRegion))
+	display.PrintActiveFilters(filterConfig.Describe())
+
+	moviesFound, moviesChecked := 0, 0
+	if len(movies) > 0 {
+		moviesFound, moviesChecked = displayFilmographyMovies(client, movies, filterConfig, func(movie *models.Movie, movieDisplay *display.MovieDisplay) {
+			movieDisplay.Character = movie.Character
+			movieDisplay.Job = movie.Job
+		})
+	}
+	showsFound, showsChecked := 0, 0
+	if len(shows) > 0 {
+		if len(movies) > 0 {
+			fmt.Printf("\n%s\n", display.HighlightStyle.Render("TV Shows"))
+		}
+		showsFound, showsChecked = displayFilmographyShows(client, shows, f.Shows, func(credit *models.Movie, showDisplay *display.ShowDisplay) {
+			showDisplay.Character = credit.Character
+			showDisplay.Job = credit.Job
+			showDisplay.EpisodeCount = credit.EpisodeCount
+		})
+	}
+
+	display.DisplaySeparator()
+	if moviesFound+showsFound == 0 {
+		fmt.Printf("No titles found for %s.\n", actor.Name)
+		fmt.Printf("(Checked %d titles meeting criteria)\n", moviesChecked+showsChecked)
+		return
+	}
+	switch f.Media {
+	case "movie":
+		fmt.Printf("Found %d movies %s %s.\n", moviesFound, filters.RoleVerb(f.Role), actor.Name)
+	case "tv":
+		fmt.Printf("Found %d shows %s %s.\n", showsFound, filters.RoleVerb(f.Role), actor.Name)
+	default:
+		fmt.Printf("Found %d movies and %d shows %s %s.\n", moviesFound, showsFound, filters.RoleVerb(f.Role), actor.Name)
+	}
+}
+
+// displayPersonDetails shows the person's details and a career summary of the listed credits
+func displayPersonDetails(client *api.Client, personID int, region string, movies, shows []models.Movie) {
+	languageCode := strings.ToLower(region) + "-" + strings.ToUpper(region)
+	person, err := client.GetPersonDetails(personID, languageCode)
+	if err != nil {
 		return
 	}
 
-	fmt.Printf("Filtering with Min Rating: %.1f | Min Votes: %d\n", finalMinRating, finalMinVotes)
-	fmt.Printf("Checking [%s] in region [%s]\n\n", finalProviders, strings.ToUpper(finalRegion))
+	age, _ := person.Age(time.Now())
+	display.DisplayPerson(display.PersonDisplay{
+		Person: *person,
+		Age:    age,
+		Career: filters.ComputeCareerStats(movies),
+		Shows:  len(shows),
+	})
+}
 
-	resultsFound := 0
-	moviesChecked := 0
+// displayCareerTimeline charts the average rating per year of all rated movies, marking
+// the ones available on the desired providers and the three best rated ones
+func displayCareerTimeline(client *api.Client, name string, movies []models.Movie, filterConfig processor.FilterConfig) {
+	timeline := filters.BuildTimeline(movies)
+
+	available := make(map[int]bool)
+	for _, year := range timeline {
+		for _, movie := range year.Films {
+			providerData, err := client.GetWatchProviders(movie.ID, filterConfig.Region)
+			if err != nil {
+				continue
+			}
+			_, available[movie.ID] = filters.CheckAvailability(providerData, filterConfig.DesiredProviders)
+		}
+	}
 
-	for _, movie := range credits.Cast {
-		if !filters.MeetsRatingCriteria(movie.VoteAverage, movie.VoteCount, finalMinRating, finalMinVotes) {
+	display.DisplayTimeline(display.TimelineDisplay{
+		Name:      name,
+		Years:     timeline,
+		Available: available,
+		Top:       filters.TopRatedFilms(timeline, 3),
+	})
+	display.DisplaySeparator()
+}
+
+// displayFilmographyMovies lists the movie credits available on the desired providers,
+// annotate adds the credit (e.g. the character) to each movie
+func displayFilmographyMovies(client *api.Client, movies []models.Movie, filterConfig processor.FilterConfig, annotate func(*models.Movie, *display.MovieDisplay)) (resultsFound, moviesChecked int) {
+	finalRegion := filterConfig.Region
+	mp := processor.NewMovieProcessor(client, filterConfig)
+	fetcher := display.NewDetailsFetcher(client, finalRegion, filterConfig.GenreList)
+	collector := newMovieCollector(filterConfig)
+	matches := 0
+
+	for _, movie := range movies {
+		if filterConfig.Seen.Contains(models.MediaTypeMovie, movie.ID) {
+			continue
+		}
+
+		// Apply rating, vote, genre and date filters
+		if !mp.MatchesMovie(&movie) {
 			continue
 		}
 
@@ -267,57 +457,69 @@ func displayActorFilmography(client *api.Client, actor models.Actor, finalRegion
 			continue
 		}
 
-		availableProviders, isAvailable := filters.CheckAvailability(providerData, desiredProviders)
-		if !isAvailable {
+		availableProviders, isAvailable := filters.CheckAvailability(providerData, filterConfig.DesiredProviders)
+		if !isAvailable || !mp.MatchesMovieExpression(&movie, availableProviders) {
 			continue
 		}
 
-		// Apply genre filter
-		if genreFilter != "" && !filters.FilterByGenre(&movie, genreFilter, genreMap) {
+		matches++
+		genreNames := filters.GetGenreNames(movie.GenreIDs, filterConfig.GenreList)
+
+		collector.Add(&movie, func(number int) display.MovieDisplay {
+			movieDisplay := fetcher.BuildMovieDisplay(number, &movie, availableProviders, genreNames)
+			annotate(&movie, &movieDisplay)
+			return movieDisplay
+		})
+
+		if matches >= config.MaxResultsToDisplay && !filterConfig.CollectAll {
+			break
+		}
+	}
+
+	return collector.Flush(), moviesChecked
+}
+
+// displayFilmographyShows lists the tv credits available on the desired providers,
+// annotate adds the credit (e.g. the character and episode count) to each show
+func displayFilmographyShows(client *api.Client, credits []models.Movie, filterConfig processor.FilterConfig, annotate func(*models.Movie, *display.ShowDisplay)) (resultsFound, showsChecked int) {
+	finalRegion := filterConfig.Region
+	mp := processor.NewMovieProcessor(client, filterConfig)
+
+	for _, credit := range credits {
+		if filterConfig.Seen.Contains(models.MediaTypeTV, credit.ID) {
 			continue
 		}
 
-		resultsFound++
-		externalIDs, _ := client.GetExternalIDs(movie.ID)
-		englishTitle, _ := client.GetEnglishTitle(movie.ID)
-		if englishTitle == "" {
-			englishTitle = movie.OriginalTitle
+		show := credit.ToShow()
+		if !mp.MatchesShow(&show) {
+			continue
 		}
 
-		// Get region-specific title (e.g., DE -> de-DE)
-		languageCode := strings.ToLower(finalRegion) + "-" + strings.ToUpper(finalRegion)
-		regionalTitle, _ := client.GetRegionalTitle(movie.ID, languageCode)
-		if regionalTitle == "" {
-			regionalTitle = movie.Title
+		showsChecked++
+
+		providerData, err := client.GetShowWatchProviders(show.ID, finalRegion)
+		if err != nil {
+			continue
 		}
 
-		genreNames := filters.GetGenreNames(movie.GenreIDs, genreList)
-
-		display.DisplayMovie(display.MovieDisplay{
-			Number:       resultsFound,
-			Title:        regionalTitle,
-			EnglishTitle: englishTitle,
-			Year:         movie.GetYear(),
-			Rating:       movie.VoteAverage,
-			Votes:        movie.VoteCount,
-			Providers:    availableProviders,
-			TmdbID:       movie.ID,
-			ImdbID:       externalIDs.ImdbID,
-			Overview:     movie.Overview,
-			Character:    movie.Character,
-			Genres:       genreNames,
-		})
+		availableProviders, isAvailable := filters.CheckAvailability(providerData, filterConfig.DesiredProviders)
+		if !isAvailable || !mp.MatchesShowExpression(&show, availableProviders) {
+			continue
+		}
+
+		resultsFound++
+		showDisplay := buildShowDisplay(client, resultsFound, &show, availableProviders)
+		showDisplay.Genres = filters.GetGenreNames(show.GenreIDs, filterConfig.GenreList)
+		annotate(&credit, &showDisplay)
+		if filterConfig.Scoring.Weighted {
+			showDisplay.WeightedRating = filterConfig.Scoring.WeightedRating(show.VoteAverage, show.VoteCount)
+		}
+		display.DisplayShow(showDisplay)
 
 		if resultsFound >= config.MaxResultsToDisplay {
 			break
 		}
 	}
 
-	display.DisplaySeparator()
-	if resultsFound == 0 {
-		fmt.Printf("No movies found for %s.\n", actor.Name)
-		fmt.Printf("(Checked %d movies meeting criteria)\n", moviesChecked)
-	} else {
-		fmt.Printf("Found %d movies starring %s.\n", resultsFound, actor.Name)
-	}
+	return resultsFound, showsChecked
 }
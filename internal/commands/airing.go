@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/processor"
+	"github.com/spf13/cobra"
+)
+
+// premiereWindowDays is the number of days ahead in which a season premiere is highlighted
+const premiereWindowDays = 7
+
+var airingFlags = MovieCommandFlags{}
+
+var (
+	airingToday bool
+)
+
+var airingCmd = &cobra.Command{
+	Use:   "airing",
+	Short: "Find TV shows airing this week on your streaming providers.",
+	Long: `Queries TMDb's On The Air list (or Airing Today with --today) and checks
+streaming availability in your region. Shows the next episode and highlights
+shows with a season premiere today or within the next seven days.
+
+Rating and vote thresholds are only applied when passed explicitly.
+
+Examples:
+  tmdb airing
+  tmdb airing --today
+  tmdb airing --providers Netflix --min-rating 7.0`,
+	Run: runAiring,
+}
+
+func init() {
+	airingFlags.Register(airingCmd, true)
+	airingFlags.RegisterSeen(airingCmd)
+	airingCmd.Flags().BoolVar(&airingToday, "today", false, "Only show episodes airing today")
+}
+
+func runAiring(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+
+	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, _ := airingFlags.Resolve(cmd, cfg)
+
+	// Currently airing shows are often too new for the global thresholds
+	if !cmd.Flags().Changed("min-rating") {
+		finalMinRating = 0
+	}
+	if !cmd.Flags().Changed("min-votes") {
+		finalMinVotes = 0
+	}
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	genreList, genreMap := LoadTVGenres(client)
+
+	filterConfig, err := airingFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	filterConfig.MinRating = finalMinRating
+	filterConfig.MinVotes = finalMinVotes
+
+	languageCode := strings.ToLower(finalRegion) + "-" + strings.ToUpper(finalRegion)
+
+	searchType := "TV Shows On The Air"
+	fetchPage := client.GetOnTheAirShows
+	if airingToday {
+		searchType = "TV Shows Airing Today"
+		fetchPage = client.GetAiringTodayShows
+	}
+
+	display.PrintSearchStartMessage(searchType, finalMinRating, finalMinVotes, finalProviders, finalRegion)
+	display.PrintActiveFilters(filterConfig.Describe())
+
+	processor := processor.NewMovieProcessor(client, filterConfig)
+
+	now := time.Now()
+	resultsFound := 0
+
+	err = processor.ProcessShows(
+		func(page int) (*models.ShowDiscoverResponse, error) {
+			return fetchPage(page, languageCode)
+		},
+		func(show *models.Show, providers []string) error {
+			resultsFound++
+			showDisplay := buildShowDisplay(client, resultsFound, show, providers)
+			showDisplay.Genres = filters.GetGenreNames(show.GenreIDs, genreList)
+			if filterConfig.Scoring.Weighted {
+				showDisplay.WeightedRating = filterConfig.Scoring.WeightedRating(show.VoteAverage, show.VoteCount)
+			}
+			addEpisodeInfo(client, &showDisplay, languageCode, now)
+			display.DisplayShow(showDisplay)
+			return nil
+		},
+	)
+
+	if err != nil {
+		fmt.Printf("Error processing shows: %v\n", err)
+		return
+	}
+
+	display.PrintSearchResultsSummary("airing TV shows", resultsFound)
+}
+
+// buildShowDisplay fetches the external IDs and English title of a show
+func buildShowDisplay(client *api.Client, number int, show *models.Show, providers []string) display.ShowDisplay {
+	externalIDs, _ := client.GetShowExternalIDs(show.ID)
+	englishTitle, _ := client.GetShowEnglishTitle(show.ID)
+	if englishTitle == "" {
+		englishTitle = show.OriginalName
+	}
+
+	return display.ShowDisplay{
+		Number:           number,
+		Title:            show.GetTitle(),
+		EnglishTitle:     englishTitle,
+		Year:             show.GetYear(),
+		Rating:           show.VoteAverage,
+		Votes:            show.VoteCount,
+		Providers:        providers,
+		TmdbID:           show.ID,
+		ImdbID:           externalIDs.ImdbID,
+		TvdbID:           externalIDs.TvdbID,
+		Overview:         show.Overview,
+		EpisodeRuntime:   show.GetEpisodeRuntime(),
+		OriginalLanguage: show.OriginalLanguage,
+		Certification:    show.Certification,
+	}
+}
+
+// addEpisodeInfo adds the next episode and season premiere highlight to a show display
+func addEpisodeInfo(client *api.Client, showDisplay *display.ShowDisplay, languageCode string, now time.Time) {
+	details, err := client.GetShowDetails(showDisplay.TmdbID, languageCode)
+	if err != nil {
+		return
+	}
+	showDisplay.NextEpisode = details.NextEpisodeToAir
+	showDisplay.SeasonPremiere = details.HasSeasonPremiereWithin(now, premiereWindowDays)
+}
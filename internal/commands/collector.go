@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/processor"
+)
+
+// movieCollector displays matching movies right away or, when a --fits budget or a
+// --sort key is set, collects them and displays the best rated set fitting the
+// budget and/or the sorted list at the end
+type movieCollector struct {
+	budget    int
+	sortKey   string
+	scoring   filters.Scoring
+	movies    []models.Movie
+	builders  []func(number int) display.MovieDisplay
+	displayed int
+}
+
+func newMovieCollector(filterConfig processor.FilterConfig) *movieCollector {
+	return &movieCollector{
+		budget:  filterConfig.FitsBudget,
+		sortKey: filterConfig.Sort,
+		scoring: filterConfig.Scoring,
+	}
+}
+
+func (c *movieCollector) collecting() bool {
+	return c.budget > 0 || c.sortKey != ""
+}
+
+// Add displays or collects a movie. build is only called for movies that are displayed.
+func (c *movieCollector) Add(movie *models.Movie, build func(number int) display.MovieDisplay) {
+	if c.scoring.Weighted {
+		build = withWeightedRating(build, c.scoring, movie.VoteAverage, movie.VoteCount)
+	}
+
+	if !c.collecting() {
+		c.displayed++
+		display.DisplayMovie(build(c.displayed))
+		return
+	}
+
+	c.movies = append(c.movies, *movie)
+	c.builders = append(c.builders, build)
+}
+
+// Flush displays the collected movies and returns the number of displayed movies
+func (c *movieCollector) Flush() int {
+	if !c.collecting() {
+		return c.displayed
+	}
+
+	var selected []int
+	if c.budget > 0 {
+		selected = filters.SelectWithinBudget(c.movies, c.budget, c.scoring)
+	} else {
+		selected = make([]int, len(c.movies))
+		for i := range c.movies {
+			selected[i] = i
+		}
+	}
+	filters.SortMovies(c.movies, selected, c.sortKey, c.scoring)
+	// Sorted results are collected from all candidates (CollectAll), only the best are displayed
+	if c.budget == 0 && len(selected) > config.MaxResultsToDisplay {
+		selected = selected[:config.MaxResultsToDisplay]
+	}
+
+	totalRuntime := 0
+	for _, i := range selected {
+		c.displayed++
+		totalRuntime += c.movies[i].Runtime
+
+		movieDisplay := c.builders[i](c.displayed)
+		if c.sortKey == "gem" {
+			movie := c.movies[i]
+			movieDisplay.GemScore = c.scoring.HiddenGemScore(movie.VoteAverage, movie.VoteCount, movie.Popularity)
+		}
+		display.DisplayMovie(movieDisplay)
+	}
+
+	if c.budget > 0 && c.displayed > 0 {
+		display.DisplaySeparator()
+		fmt.Printf("Total runtime: %s of %s (picked from %d candidates)\n",
+			models.FormatRuntime(totalRuntime), models.FormatRuntime(c.budget), len(c.movies))
+	}
+	return c.displayed
+}
+
+// withWeightedRating wraps a display builder to also show the weighted rating
+func withWeightedRating(build func(number int) display.MovieDisplay, scoring filters.Scoring, average float64, votes int) func(number int) display.MovieDisplay {
+	return func(number int) display.MovieDisplay {
+		movieDisplay := build(number)
+		movieDisplay.WeightedRating = scoring.WeightedRating(average, votes)
+		return movieDisplay
+	}
+}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/spf13/cobra"
+)
+
+var companyFlags = MovieCommandFlags{}
+
+var (
+	companyList          bool
+	companyStreamingOnly bool
+)
+
+var companyCmd = &cobra.Command{
+	Use:   "company [name]",
+	Short: "List a studio's films, best rated first, with availability on your providers.",
+	Long: `Looks up a production company (e.g. "A24" or "Studio Ghibli") and lists its
+films ordered by rating with their availability on your providers. Films that
+are not streaming on your providers are listed as well, unless --streaming-only
+is set. Use --list to see all matching companies and their IDs.
+
+Examples:
+  tmdb company "A24"
+  tmdb company "Studio Ghibli" --min-votes 100
+  tmdb company "A24" --streaming-only
+  tmdb company "Warner" --list`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  runCompany,
+}
+
+func init() {
+	companyFlags.Register(companyCmd, true)
+	companyFlags.RegisterSeen(companyCmd)
+	companyFlags.RegisterFits(companyCmd)
+	companyFlags.RegisterSort(companyCmd)
+	companyCmd.Flags().BoolVar(&companyList, "list", false, "List matching companies instead of movies")
+	companyCmd.Flags().BoolVar(&companyStreamingOnly, "streaming-only", false, "Only show films streaming on your providers")
+}
+
+func runCompany(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+	query := strings.Join(args, " ")
+
+	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, _ := companyFlags.Resolve(cmd, cfg)
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	companyResp, err := client.SearchCompany(query)
+	if err != nil {
+		fmt.Printf("Error searching companies: %v\n", err)
+		return
+	}
+
+	if len(companyResp.Results) == 0 {
+		fmt.Printf("No companies found for \"%s\"\n", query)
+		return
+	}
+
+	if companyList {
+		for _, c := range companyResp.Results {
+			country := ""
+			if c.OriginCountry != "" {
+				country = " (" + c.OriginCountry + ")"
+			}
+			fmt.Printf("%8d  %s%s\n", c.ID, c.Name, country)
+		}
+		fmt.Printf("\nFound %d companies. Use a name or ID with --company on any list command.\n", len(companyResp.Results))
+		return
+	}
+
+	company, err := filters.BestCompanyMatch(query, companyResp.Results)
+	if err != nil {
+		fmt.Printf("Error: %v (see --list)\n", err)
+		return
+	}
+
+	genreList, genreMap := LoadGenres(client)
+
+	filterConfig, err := companyFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	filterConfig.SkipProviderFilter = !companyStreamingOnly
+
+	fmt.Printf("🎬 Company: %s (ID %d)\n", display.HighlightStyle.Render(company.Name), company.ID)
+	if len(companyResp.Results) > 1 {
+		fmt.Printf("   %d more matching companies, see: tmdb company \"%s\" --list\n", len(companyResp.Results)-1, query)
+	}
+	fmt.Println()
+
+	display.PrintSearchStartMessage("Movies by "+company.Name, finalMinRating, finalMinVotes, finalProviders, finalRegion)
+	display.PrintActiveFilters(filterConfig.Describe())
+
+	// Additional --company filters are still checked per movie by the processor
+	discoverParams := filterConfig.DiscoverParams("vote_average.desc")
+	discoverParams.WithCompanies = strconv.Itoa(company.ID)
+
+	runDiscoverList(client, filterConfig, discoverParams, "movies by "+company.Name)
+}
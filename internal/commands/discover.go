@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/processor"
+)
+
+// runDiscoverList lists the best rated discover results on the desired providers
+// (or all of them with their availability, if the provider filter is skipped).
+// It is shared by commands that list the movies of one entity (keyword, company);
+// the discover params already restrict the results to that entity.
+func runDiscoverList(client *api.Client, filterConfig processor.FilterConfig, discoverParams api.DiscoverParams, summary string) {
+	processor := processor.NewMovieProcessor(client, filterConfig)
+
+	fetcher := display.NewDetailsFetcher(client, filterConfig.Region, filterConfig.GenreList)
+	collector := newMovieCollector(filterConfig)
+
+	err := processor.Process(
+		func(page int) (*models.DiscoverResponse, error) {
+			return client.DiscoverMovies(page, filterConfig.Region, discoverParams)
+		},
+		func(movie *models.Movie, providers []string, genres []string) error {
+			collector.Add(movie, func(number int) display.MovieDisplay {
+				return fetcher.BuildMovieDisplay(number, movie, providers, genres)
+			})
+			return nil
+		},
+	)
+
+	if err != nil {
+		fmt.Printf("Error processing movies: %v\n", err)
+		return
+	}
+
+	resultsFound := collector.Flush()
+
+	display.PrintSearchResultsSummary(summary, resultsFound)
+}
@@ -0,0 +1,215 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var findFlags = MovieCommandFlags{}
+
+var (
+	findType       string
+	findMaxResults int
+)
+
+var findCmd = &cobra.Command{
+	Use:     "find [query] [index]",
+	Aliases: []string{"multi"},
+	Short:   "Search movies, TV shows and people at once.",
+	Long: `Search movies, TV shows and people with a single query and list all
+matches with their type. Specify an index to open the detail view of a result:
+movies and shows are displayed with their streaming availability, people with
+their filmography.
+
+Examples:
+  tmdb find "matrix"
+  tmdb find "matrix" 2
+  tmdb find "office" --type tv
+  tmdb find "dune" --type movie --year 1984`,
+	Args: cobra.RangeArgs(1, 2),
+	Run:  runFind,
+}
+
+func init() {
+	// Only the type, date and seen filters apply to the result list
+	findFlags.RegisterBasic(findCmd)
+	findFlags.RegisterDates(findCmd)
+	findFlags.RegisterSeen(findCmd)
+	findCmd.Flags().StringVar(&findType, "type", "", "Only show results of this type (movie, tv, person)")
+	findCmd.Flags().IntVar(&findMaxResults, "max", 20, "Maximum results to display")
+}
+
+func runFind(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+	query := args[0]
+	resultIndex := -1
+
+	if len(args) > 1 {
+		index, err := strconv.Atoi(args[1])
+		if err != nil || index < 1 {
+			fmt.Printf("Invalid index: %s. Please provide a positive number (1, 2, 3, ...)\n", args[1])
+			return
+		}
+		resultIndex = index - 1
+	}
+
+	mediaType := strings.ToLower(findType)
+	if mediaType != "" && mediaType != models.MediaTypeMovie && mediaType != models.MediaTypeTV && mediaType != models.MediaTypePerson {
+		fmt.Printf("Invalid type: %s. Use movie, tv or person\n", findType)
+		return
+	}
+
+	finalRegion, finalProviders, _, _, finalTimeout, _ := findFlags.Resolve(cmd, cfg)
+
+	dateRange, err := findFlags.ResolveDateRange()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	seen, err := findFlags.ResolveSeen(cmd)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	languageCode := strings.ToLower(finalRegion) + "-" + strings.ToUpper(finalRegion)
+
+	fmt.Printf("🔍 Searching movies, shows and people for: \"%s\"\n\n", query)
+
+	searchResp, err := client.SearchMulti(query, languageCode, false)
+	if err != nil {
+		fmt.Printf("Error searching: %v\n", err)
+		return
+	}
+
+	results := []models.MultiSearchResult{}
+	for _, result := range filters.FilterMultiSearchResults(searchResp.Results, mediaType, dateRange) {
+		if !seen.Contains(result.MediaType, result.ID) {
+			results = append(results, result)
+		}
+	}
+	if len(results) > findMaxResults {
+		results = results[:findMaxResults]
+	}
+
+	if len(results) == 0 {
+		fmt.Printf("No results found for \"%s\"\n", query)
+		return
+	}
+
+	if resultIndex < 0 {
+		display.DisplaySeparator()
+		for i, result := range results {
+			display.DisplaySearchResult(buildSearchResultDisplay(i+1, result))
+		}
+		display.DisplaySeparator()
+		fmt.Printf("Found %d results. To open one, use:\n  tmdb find \"%s\" 1\n", len(results), query)
+		return
+	}
+
+	if resultIndex >= len(results) {
+		fmt.Printf("Invalid index: %d. Found only %d results for '%s' (use 1-%d)\n",
+			resultIndex+1, len(results), query, len(results))
+		return
+	}
+
+	desiredProviders := filters.ParseProviders(finalProviders)
+	result := results[resultIndex]
+
+	switch result.MediaType {
+	case models.MediaTypeMovie:
+		displayMovieDetails(client, result.ID, finalRegion, desiredProviders)
+	case models.MediaTypeTV:
+		displayShowDetails(client, result.ToShow(), finalRegion, desiredProviders)
+	case models.MediaTypePerson:
+		genreList, genreMap := LoadGenres(client)
+		filterConfig, err := findFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		filmography, err := newFilmography(cmd, cfg, client, &findFlags, filterConfig, filters.RoleForDepartment(result.KnownForDepartment), "all")
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		filmography.Providers = finalProviders
+		displayActorFilmography(client, result.ToActor(), filmography)
+	}
+}
+
+func buildSearchResultDisplay(number int, result models.MultiSearchResult) display.SearchResultDisplay {
+	knownFor := ""
+	if result.MediaType == models.MediaTypePerson {
+		parts := []string{}
+		if result.KnownForDepartment != "" {
+			parts = append(parts, result.KnownForDepartment)
+		}
+		for _, movie := range result.KnownFor {
+			parts = append(parts, movie.GetTitle())
+		}
+		knownFor = strings.Join(parts, ", ")
+	}
+
+	return display.SearchResultDisplay{
+		Number:           number,
+		MediaType:        result.MediaType,
+		Title:            result.GetTitle(),
+		Year:             result.GetYear(),
+		Rating:           result.VoteAverage,
+		Votes:            result.VoteCount,
+		KnownFor:         knownFor,
+		OriginalLanguage: result.OriginalLanguage,
+		TmdbID:           result.ID,
+	}
+}
+
+// displayMovieDetails shows a single movie with its availability on the desired providers
+func displayMovieDetails(client *api.Client, movieID int, region string, desiredProviders map[string]bool) {
+	languageCode := strings.ToLower(region) + "-" + strings.ToUpper(region)
+	movie, err := client.GetMovieDetails(movieID, languageCode)
+	if err != nil {
+		fmt.Printf("Error fetching movie details: %v\n", err)
+		return
+	}
+
+	var availableProviders []string
+	if providerData, err := client.GetWatchProviders(movie.ID, region); err == nil {
+		availableProviders, _ = filters.CheckAvailability(providerData, desiredProviders)
+	}
+
+	fetcher := display.NewDetailsFetcher(client, region, nil)
+	display.DisplayMovie(fetcher.BuildMovieDisplay(1, movie, availableProviders, movie.GetGenreNames()))
+	display.DisplaySeparator()
+}
+
+// displayShowDetails shows a single TV show with its availability and next episode
+func displayShowDetails(client *api.Client, show models.Show, region string, desiredProviders map[string]bool) {
+	languageCode := strings.ToLower(region) + "-" + strings.ToUpper(region)
+
+	var availableProviders []string
+	if providerData, err := client.GetShowWatchProviders(show.ID, region); err == nil {
+		availableProviders, _ = filters.CheckAvailability(providerData, desiredProviders)
+	}
+
+	showDisplay := buildShowDisplay(client, 1, &show, availableProviders)
+	addEpisodeInfo(client, &showDisplay, languageCode, time.Now())
+	display.DisplayShow(showDisplay)
+	display.DisplaySeparator()
+}
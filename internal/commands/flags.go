@@ -1,31 +1,128 @@
 package commands
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/api"
 	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/expr"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/processor"
+	"github.com/sebastianneubert/tmdb/internal/store"
 	"github.com/spf13/cobra"
 )
 
 // MovieCommandFlags holds all the common flag values for movie-related commands
 type MovieCommandFlags struct {
-	Providers string
-	Region    string
-	MinRating float64
-	MinVotes  int
-	Timeout   int
-	Genre     string
+	Providers    string
+	Region       string
+	MinRating    float64
+	MinVotes     int
+	Timeout      int
+	Genre        string
+	ExcludeGenre string
+	Year         int
+	From         string
+	To           string
+	Decade       string
+	MinRuntime   int
+	MaxRuntime   int
+	Fits         string
+	Where        string
+	// Company and Network are comma-separated names or IDs
+	Company string
+	Network string
+	// Keyword and ExcludeKeyword are comma-separated keyword names or IDs
+	Keyword        string
+	ExcludeKeyword string
+	Sort           string
+	// MaxCertification is an age (12) or a certification of the region (PG-13)
+	MaxCertification string
+	// Scoring is the rating mode (raw or weighted), PriorMean and PriorVotes its prior
+	Scoring    string
+	PriorMean  float64
+	PriorVotes int
+	// OriginalLanguage and OriginCountry are comma-separated ISO codes
+	OriginalLanguage string
+	OriginCountry    string
+	// IncludeSeen keeps the titles of the seen history in the results
+	IncludeSeen bool
 }
 
 // Register registers all flags with the given command
 // If includeGenre is false, the genre flag will not be registered
 func (f *MovieCommandFlags) Register(cmd *cobra.Command, includeGenre bool) {
-	cmd.Flags().StringVarP(&f.Providers, "providers", "p", config.DefaultProviders, "Comma-separated providers")
-	cmd.Flags().StringVarP(&f.Region, "region", "r", config.DefaultRegion, "Watch region")
+	f.RegisterBasic(cmd)
 	cmd.Flags().Float64Var(&f.MinRating, "min-rating", config.DefaultMinRating, "Minimum rating")
 	cmd.Flags().IntVar(&f.MinVotes, "min-votes", config.DefaultMinVotes, "Minimum votes")
-	cmd.Flags().IntVarP(&f.Timeout, "timeout", "T", config.DefaultTimeout, "Timeout in seconds")
 	if includeGenre {
-		cmd.Flags().StringVar(&f.Genre, "genre", "", "Filter by genre (name or ID)")
+		cmd.Flags().StringVar(&f.Genre, "genre", "", "Filter by genre (name or ID); 'Action,Thriller' matches any, 'Drama+Romance' all")
+		cmd.Flags().StringVar(&f.ExcludeGenre, "exclude-genre", "", "Exclude comma-separated genres (name or ID)")
+	}
+	f.RegisterDates(cmd)
+	cmd.Flags().IntVar(&f.MinRuntime, "min-runtime", 0, "Minimum runtime in minutes (episode runtime for shows)")
+	cmd.Flags().IntVar(&f.MaxRuntime, "max-runtime", 0, "Maximum runtime in minutes (episode runtime for shows)")
+	cmd.Flags().StringVar(&f.Keyword, "keyword", "", "Only titles with any of these comma-separated keywords (name or ID, e.g. 'heist,time travel')")
+	cmd.Flags().StringVar(&f.ExcludeKeyword, "exclude-keyword", "", "Exclude titles with any of these comma-separated keywords (name or ID)")
+	cmd.Flags().StringVar(&f.Company, "company", "", "Only titles by any of these comma-separated production companies (name or ID, e.g. 'A24,Studio Ghibli')")
+	cmd.Flags().StringVar(&f.Network, "network", "", "Only shows aired on any of these comma-separated networks (name or ID, e.g. HBO)")
+	cmd.Flags().StringVar(&f.OriginalLanguage, "original-language", "", "Only titles in these original languages (comma-separated ISO 639-1 codes, e.g. ko,ja)")
+	cmd.Flags().StringVar(&f.OriginCountry, "origin-country", "", "Only titles from these countries (comma-separated ISO 3166-1 codes, e.g. KR)")
+	cmd.Flags().StringVar(&f.MaxCertification, "max-certification", "", "Maximum age rating in your region, as age (12) or certification (PG-13)")
+	cmd.Flags().StringVar(&f.Scoring, "scoring", filters.ScoringRaw, "Rating mode: raw (vote average and min votes) or weighted (Bayesian weighted rating)")
+	cmd.Flags().Float64Var(&f.PriorMean, "prior-mean", config.DefaultPriorMean, "Prior mean rating of the weighted rating")
+	cmd.Flags().IntVar(&f.PriorVotes, "prior-votes", config.DefaultPriorVotes, "Weight of the prior in votes for the weighted rating")
+	cmd.Flags().StringVar(&f.Where, "where", "", "Filter expression, e.g. 'rating >= 7 && \"Comedy\" in genres', or @name for a saved search")
+}
+
+// RegisterBasic registers only the providers, region and timeout flags, for commands
+// that don't filter movie lists
+func (f *MovieCommandFlags) RegisterBasic(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.Providers, "providers", "p", config.DefaultProviders, "Comma-separated providers")
+	cmd.Flags().StringVarP(&f.Region, "region", "r", config.DefaultRegion, "Watch region")
+	cmd.Flags().IntVarP(&f.Timeout, "timeout", "T", config.DefaultTimeout, "Timeout in seconds")
+}
+
+// RegisterDates registers the --year, --from, --to and --decade flags
+func (f *MovieCommandFlags) RegisterDates(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&f.Year, "year", 0, "Only titles released in this year")
+	cmd.Flags().StringVar(&f.From, "from", "", "Only titles released on or after this year or date (1990 or 1990-05-17)")
+	cmd.Flags().StringVar(&f.To, "to", "", "Only titles released on or before this year or date (1999 or 1999-12-31)")
+	cmd.Flags().StringVar(&f.Decade, "decade", "", "Only titles released in this decade (e.g. 1990s or 90s)")
+}
+
+// RegisterFits registers the --fits flag for commands that support picking titles for a time budget
+func (f *MovieCommandFlags) RegisterFits(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.Fits, "fits", "", "Pick the best rated titles that fit into this time budget (e.g. 2h30m)")
+}
+
+// RegisterSort registers the --sort flag for commands that collect their results before display
+func (f *MovieCommandFlags) RegisterSort(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.Sort, "sort", "", "Sort results by "+strings.Join(filters.SortKeys, ", ")+" (gem = hidden-gem score)")
+}
+
+// RegisterSeen registers the --include-seen flag for commands that hide seen titles
+func (f *MovieCommandFlags) RegisterSeen(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&f.IncludeSeen, "include-seen", false, "Include titles of your seen history (tmdb seen)")
+}
+
+// ResolveSeen loads the seen history to hide, empty if the command doesn't hide seen
+// titles or --include-seen is given
+func (f *MovieCommandFlags) ResolveSeen(cmd *cobra.Command) (filters.SeenFilter, error) {
+	if cmd.Flags().Lookup("include-seen") == nil || f.IncludeSeen {
+		return filters.SeenFilter{}, nil
 	}
+	seen, err := store.OpenList(store.Seen)
+	if err != nil {
+		return filters.SeenFilter{}, err
+	}
+	filter := filters.NewSeenFilter()
+	for _, item := range seen.Items {
+		filter.Add(item.MediaType, item.ID)
+	}
+	return filter, nil
 }
 
 // Resolve returns the final values by combining config defaults with any command-line overrides
@@ -59,3 +156,195 @@ func (f *MovieCommandFlags) Resolve(cmd *cobra.Command, cfg config.Config) (regi
 
 	return
 }
+
+// ResolveDateRange combines --year, --from, --to and --decade into a single date range
+func (f *MovieCommandFlags) ResolveDateRange() (filters.DateRange, error) {
+	return filters.ParseDateRange(f.Year, f.From, f.To, f.Decade)
+}
+
+// ResolveWhere parses the --where expression (or the WHERE config default).
+// A value of @name refers to a saved search stored as WHERE_<NAME> in the config.
+func (f *MovieCommandFlags) ResolveWhere(cmd *cobra.Command, cfg config.Config) (*expr.Expression, error) {
+	where := cfg.Where
+	if cmd.Flags().Changed("where") {
+		where = f.Where
+	}
+
+	where = strings.TrimSpace(where)
+	if where == "" {
+		return nil, nil
+	}
+
+	if strings.HasPrefix(where, "@") {
+		name := where[1:]
+		saved, ok := config.SavedSearch(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown saved search %q (set WHERE_%s in your config)", name, strings.ToUpper(name))
+		}
+		where = saved
+	}
+
+	expression, err := expr.Parse(where)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --where expression: %s", expr.FormatError(where, err))
+	}
+	return expression, nil
+}
+
+// ResolveScoring combines --scoring, --prior-mean and --prior-votes with the config defaults
+func (f *MovieCommandFlags) ResolveScoring(cmd *cobra.Command, cfg config.Config) (filters.Scoring, error) {
+	mode := cfg.Scoring
+	if cmd.Flags().Changed("scoring") {
+		mode = f.Scoring
+	}
+	weighted, err := filters.ParseScoringMode(mode)
+	if err != nil {
+		return filters.Scoring{}, err
+	}
+
+	scoring := filters.Scoring{PriorMean: cfg.PriorMean, PriorVotes: cfg.PriorVotes, Weighted: weighted}
+	if cmd.Flags().Changed("prior-mean") {
+		scoring.PriorMean = f.PriorMean
+	}
+	if cmd.Flags().Changed("prior-votes") {
+		scoring.PriorVotes = f.PriorVotes
+	}
+
+	if scoring.PriorMean <= 0 || scoring.PriorMean > 10 {
+		return filters.Scoring{}, fmt.Errorf("--prior-mean must be greater than 0 and at most 10, got %g", scoring.PriorMean)
+	}
+	if scoring.PriorVotes < 0 {
+		return filters.Scoring{}, fmt.Errorf("--prior-votes must not be negative")
+	}
+	return scoring, nil
+}
+
+// ResolveCertification returns the maximum certification: the flag, the config
+// default, or the kids mode default. An empty value disables the filter.
+func (f *MovieCommandFlags) ResolveCertification(cmd *cobra.Command, cfg config.Config) string {
+	if cmd.Flags().Changed("max-certification") {
+		return strings.TrimSpace(f.MaxCertification)
+	}
+	if cfg.MaxCertification == "" && cfg.KidsMode {
+		return config.DefaultKidsCertification
+	}
+	return cfg.MaxCertification
+}
+
+// FilterConfig resolves all flags into the configuration used by processor.MovieProcessor
+// The client is only used to look up keywords, companies and networks.
+func (f *MovieCommandFlags) FilterConfig(cmd *cobra.Command, cfg config.Config, client *api.Client, genreList []models.Genre, genreMap map[string]int) (processor.FilterConfig, error) {
+	region, providers, minRating, minVotes, _, genre := f.Resolve(cmd, cfg)
+
+	dateRange, err := f.ResolveDateRange()
+	if err != nil {
+		return processor.FilterConfig{}, err
+	}
+
+	if f.MinRuntime > 0 && f.MaxRuntime > 0 && f.MinRuntime > f.MaxRuntime {
+		return processor.FilterConfig{}, fmt.Errorf("--min-runtime (%d) is greater than --max-runtime (%d)", f.MinRuntime, f.MaxRuntime)
+	}
+
+	fitsBudget, err := filters.ParseTimeBudget(f.Fits)
+	if err != nil {
+		return processor.FilterConfig{}, err
+	}
+
+	genres, err := filters.ParseGenreFilter(genre, f.ExcludeGenre, genreMap)
+	if err != nil {
+		return processor.FilterConfig{}, err
+	}
+
+	originalLanguages, err := filters.ParseLanguageCodes(f.OriginalLanguage)
+	if err != nil {
+		return processor.FilterConfig{}, err
+	}
+
+	originCountries, err := filters.ParseCountryCodes(f.OriginCountry)
+	if err != nil {
+		return processor.FilterConfig{}, err
+	}
+
+	sortKey, err := filters.ParseSortKey(f.Sort)
+	if err != nil {
+		return processor.FilterConfig{}, err
+	}
+
+	keywords := filters.KeywordFilter{}
+	if f.Keyword != "" || f.ExcludeKeyword != "" {
+		keywords, err = LoadKeywords(client, f.Keyword, f.ExcludeKeyword)
+		if err != nil {
+			return processor.FilterConfig{}, err
+		}
+	}
+
+	var companies []models.Company
+	if f.Company != "" {
+		if companies, err = LoadCompanies(client, f.Company); err != nil {
+			return processor.FilterConfig{}, err
+		}
+	}
+
+	var networks []models.Network
+	if f.Network != "" {
+		if networks, err = LoadNetworks(client, f.Network); err != nil {
+			return processor.FilterConfig{}, err
+		}
+	}
+
+	maxCertification := f.ResolveCertification(cmd, cfg)
+	maxCertificationAge := 0
+	if maxCertification != "" {
+		maxCertificationAge, err = filters.ParseMaxCertification(region, maxCertification)
+		if err != nil {
+			return processor.FilterConfig{}, err
+		}
+	}
+
+	scoring, err := f.ResolveScoring(cmd, cfg)
+	if err != nil {
+		return processor.FilterConfig{}, err
+	}
+
+	where, err := f.ResolveWhere(cmd, cfg)
+	if err != nil {
+		return processor.FilterConfig{}, err
+	}
+
+	var englishGenreList []models.Genre
+	if where != nil && where.Uses("genres") {
+		englishGenreList = LoadEnglishGenres(client)
+	}
+
+	seen, err := f.ResolveSeen(cmd)
+	if err != nil {
+		return processor.FilterConfig{}, err
+	}
+
+	return processor.FilterConfig{
+		MinRating:           minRating,
+		MinVotes:            minVotes,
+		Region:              region,
+		Genres:              genres,
+		DesiredProviders:    filters.ParseProviders(providers),
+		GenreList:           genreList,
+		GenreMap:            genreMap,
+		EnglishGenreList:    englishGenreList,
+		DateRange:           dateRange,
+		MinRuntime:          f.MinRuntime,
+		MaxRuntime:          f.MaxRuntime,
+		FitsBudget:          fitsBudget,
+		CollectAll:          fitsBudget > 0 || sortKey != "",
+		Keywords:            keywords,
+		Companies:           companies,
+		Networks:            networks,
+		OriginalLanguages:   originalLanguages,
+		OriginCountries:     originCountries,
+		MaxCertification:    maxCertification,
+		MaxCertificationAge: maxCertificationAge,
+		Sort:                sortKey,
+		Scoring:             scoring,
+		Where:               where,
+		Seen:                seen,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/processor"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// gemsMinVotes keeps out titles with too few votes to judge, unless --min-votes is set
+	gemsMinVotes = 50
+	// gemsMaxVotes is the default vote count above which a title is no longer hidden
+	gemsMaxVotes = 5000
+)
+
+var gemsFlags = MovieCommandFlags{}
+
+var (
+	gemsMaxVoteCount int
+)
+
+var gemsCmd = &cobra.Command{
+	Use:   "gems",
+	Short: "Find hidden gems on your streaming providers.",
+	Long: `Scans well-rated but lesser-known movies from TMDb's discover endpoint and
+ranks all candidates on your providers by their hidden-gem score: the vote average
+shrunk toward a prior based on the vote count (Bayesian weighted rating),
+lowered for popular titles.
+
+The minimum vote count defaults to 50 instead of the configured MIN_VOTES.
+
+Examples:
+  tmdb gems
+  tmdb gems --genre Thriller --original-language ko
+  tmdb gems --max-votes 1000 --decade 2010s`,
+	Run: runGems,
+}
+
+func init() {
+	gemsFlags.Register(gemsCmd, true)
+	gemsFlags.RegisterSeen(gemsCmd)
+	gemsFlags.RegisterFits(gemsCmd)
+	gemsCmd.Flags().IntVar(&gemsMaxVoteCount, "max-votes", gemsMaxVotes, "Maximum votes, titles with more are not hidden")
+}
+
+func runGems(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+
+	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, _ := gemsFlags.Resolve(cmd, cfg)
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	genreList, genreMap := LoadGenres(client)
+
+	filterConfig, err := gemsFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if !cmd.Flags().Changed("min-votes") {
+		finalMinVotes = gemsMinVotes
+		filterConfig.MinVotes = gemsMinVotes
+	}
+	filterConfig.Sort = "gem"
+	// All candidates are ranked by their score, not just the first matches in API order
+	filterConfig.CollectAll = true
+
+	display.PrintSearchStartMessage("Hidden Gems", finalMinRating, finalMinVotes, finalProviders, finalRegion)
+	display.PrintActiveFilters(filterConfig.Describe())
+
+	discoverParams := filterConfig.DiscoverParams("vote_average.desc")
+	discoverParams.MaxVotes = gemsMaxVoteCount
+	if providerList, err := client.GetMovieProviderList(finalRegion); err == nil {
+		discoverParams.WithWatchProviders = filters.ProviderIDs(providerList, filterConfig.DesiredProviders)
+		discoverParams.WatchRegion = finalRegion
+	}
+
+	processor := processor.NewMovieProcessor(client, filterConfig)
+
+	fetcher := display.NewDetailsFetcher(client, finalRegion, genreList)
+	collector := newMovieCollector(filterConfig)
+
+	err = processor.Process(
+		func(page int) (*models.DiscoverResponse, error) {
+			return client.DiscoverMovies(page, finalRegion, discoverParams)
+		},
+		func(movie *models.Movie, providers []string, genres []string) error {
+			collector.Add(movie, func(number int) display.MovieDisplay {
+				return fetcher.BuildMovieDisplay(number, movie, providers, genres)
+			})
+			return nil
+		},
+	)
+
+	if err != nil {
+		fmt.Printf("Error processing movies: %v\n", err)
+		return
+	}
+
+	resultsFound := collector.Flush()
+
+	display.PrintSearchResultsSummary("hidden gems", resultsFound)
+}
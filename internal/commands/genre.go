@@ -6,43 +6,54 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/sebastianneubert/tmdb/internal/api"
 	"github.com/sebastianneubert/tmdb/internal/config"
 	"github.com/sebastianneubert/tmdb/internal/display"
 )
 
 var (
 	genresLanguage string
+	genresTV       bool
 )
 
 var genresCmd = &cobra.Command{
 	Use:   "genres",
 	Short: "List all available movie genres.",
 	Long: `Display a list of all movie genres available on TMDb.
-Use genre IDs or names with --genre flag in other commands.
+Use genre IDs or names with --genre and --exclude-genre flags in other commands.
+Names are accepted in German and English.
+TV shows use their own genre list, shown with --tv.
 
 Examples:
   tmdb genres
-  tmdb genres --language en-US`,
+  tmdb genres --language en-US
+  tmdb genres --tv`,
 	Run: runGenres,
 }
 
 func init() {
 	genresCmd.Flags().StringVarP(&genresLanguage, "language", "l", "de-DE", "Language for genre names")
+	genresCmd.Flags().BoolVar(&genresTV, "tv", false, "List TV show genres instead of movie genres")
 }
 
 func runGenres(cmd *cobra.Command, args []string) {
 	cfg := config.Get()
 
-	client, err := api.NewClient(cfg.APIKey, cfg.Timeout)
+	client, err := newClient(cfg.APIKey, cfg.Timeout)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		return
 	}
 
-	fmt.Printf("🎭 Fetching movie genres...\n\n")
+	kind := "Movie"
+	fetchGenres := client.GetGenres
+	if genresTV {
+		kind = "TV Show"
+		fetchGenres = client.GetTVGenres
+	}
+
+	fmt.Printf("🎭 Fetching %s genres...\n\n", strings.ToLower(kind))
 
-	genreResp, err := client.GetGenres(genresLanguage)
+	genreResp, err := fetchGenres(genresLanguage)
 	if err != nil {
 		fmt.Printf("Error fetching genres: %v\n", err)
 		return
@@ -59,7 +70,7 @@ func runGenres(cmd *cobra.Command, args []string) {
 	})
 
 	fmt.Println(display.SeparatorStyle.Render(strings.Repeat("=", 60)))
-	fmt.Printf("Available Movie Genres (%d total)\n", len(genreResp.Genres))
+	fmt.Printf("Available %s Genres (%d total)\n", kind, len(genreResp.Genres))
 	fmt.Println(display.SeparatorStyle.Render(strings.Repeat("=", 60)))
 
 	// Display in two columns
@@ -84,4 +95,6 @@ func runGenres(cmd *cobra.Command, args []string) {
 	fmt.Println("   tmdb top --genre Action")
 	fmt.Println("   tmdb search \"star\" --genre \"Science Fiction\"")
 	fmt.Println("   tmdb actor \"Tom Hanks\" --genre Drama")
+	fmt.Println("   tmdb top --genre \"Action,Thriller\" --exclude-genre Horror")
+	fmt.Println("   tmdb popular --genre \"Drama+Romance\"")
 }
\ No newline at end of file
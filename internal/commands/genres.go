@@ -9,9 +9,37 @@ import (
 // LoadGenres fetches the genre list from TMDB API and returns both the list and a map for quick lookup
 // Returns empty slices/maps if the API call fails (doesn't crash, just skips genre functionality)
 func LoadGenres(client *api.Client) ([]models.Genre, map[string]int) {
-	genreResp, err := client.GetGenres("de-DE")
+	return loadGenres(client.GetGenres)
+}
+
+// LoadTVGenres works like LoadGenres for TV show genres, which differ from movie genres
+func LoadTVGenres(client *api.Client) ([]models.Genre, map[string]int) {
+	return loadGenres(client.GetTVGenres)
+}
+
+// LoadEnglishGenres returns the English movie and TV genres for the genres field of
+// --where expressions. Both lists use the same ID for the genres they share.
+func LoadEnglishGenres(client *api.Client) []models.Genre {
+	genres := []models.Genre{}
+	for _, fetch := range []func(string) (*models.GenreListResponse, error){client.GetGenres, client.GetTVGenres} {
+		if genreResp, err := fetch("en-US"); err == nil {
+			genres = append(genres, genreResp.Genres...)
+		}
+	}
+	return genres
+}
+
+// loadGenres returns the German genre list for display. The lookup map accepts the
+// English names as well, so --genre "Drama+Romance" works next to "Drama+Liebesfilm".
+func loadGenres(fetch func(language string) (*models.GenreListResponse, error)) ([]models.Genre, map[string]int) {
+	genreResp, err := fetch("de-DE")
 	if err != nil {
 		return []models.Genre{}, map[string]int{}
 	}
-	return genreResp.Genres, filters.BuildGenreMap(genreResp.Genres)
+
+	englishResp, err := fetch("en-US")
+	if err != nil {
+		return genreResp.Genres, filters.BuildGenreMap(genreResp.Genres)
+	}
+	return genreResp.Genres, filters.BuildGenreMap(genreResp.Genres, englishResp.Genres)
 }
@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/graph"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/processor"
+	"github.com/spf13/cobra"
+)
+
+var graphFlags = MovieCommandFlags{}
+
+// Graph size limits, smaller than for path since every discovered person is expanded
+const (
+	graphBudget             = 300
+	graphMaxMoviesPerPerson = 10
+	graphMaxCastPerMovie    = 10
+)
+
+var (
+	graphDepth         int
+	graphFormat        string
+	graphMode          string
+	graphOutput        string
+	graphRequestBudget int
+)
+
+var graphCmd = &cobra.Command{
+	Use:   "graph [name]",
+	Short: "Export a person's collaboration network as DOT, GraphML or JSON.",
+	Long: `Builds a co-star graph from the credits of a person and, up to --depth hops,
+of their co-stars. Edge weights are the number of shared films. With --mode
+bipartite, people are connected to their movies instead.
+
+Each person's most popular movies and their top billed cast are followed.
+Rating, vote, genre and date filters prune low-rated or obscure titles before
+the export. Progress is printed to stderr, so the graph can be piped.
+
+If the name matches several people, pick one by appending its index, e.g. "Tom:1".
+
+Examples:
+  tmdb graph "Kevin Bacon" --depth 2 --format dot --output bacon.dot
+  tmdb graph "Kevin Bacon" | dot -Tsvg > bacon.svg
+  tmdb graph "Greta Gerwig" --mode bipartite --format graphml --min-votes 500
+  tmdb graph "Tom Hanks" --format json --min-rating 7`,
+	Args: cobra.ExactArgs(1),
+	Run:  runGraph,
+}
+
+func init() {
+	graphFlags.Register(graphCmd, true)
+	graphCmd.Flags().IntVar(&graphDepth, "depth", 1, "Number of co-star hops from the person")
+	graphCmd.Flags().StringVar(&graphFormat, "format", "dot", "Export format: "+strings.Join(graph.Formats, ", "))
+	graphCmd.Flags().StringVar(&graphMode, "mode", "costar", "Graph type: costar (people, weighted by shared films) or bipartite (people and movies)")
+	graphCmd.Flags().StringVarP(&graphOutput, "output", "o", "", "Write the graph to this file instead of stdout")
+	graphCmd.Flags().IntVar(&graphRequestBudget, "budget", graphBudget, "Maximum number of API requests, 0 for no limit")
+}
+
+func runGraph(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+
+	format, err := graph.ParseFormat(graphFormat)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return
+	}
+	if graphMode != "costar" && graphMode != "bipartite" {
+		fmt.Fprintf(os.Stderr, "Error: invalid mode %q (use costar or bipartite)\n", graphMode)
+		return
+	}
+
+	finalRegion, _, _, _, finalTimeout, _ := graphFlags.Resolve(cmd, cfg)
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return
+	}
+
+	genreList, genreMap := LoadGenres(client)
+
+	filterConfig, err := graphFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return
+	}
+
+	// The graph may go to stdout, so the person lookup reports to stderr
+	var person models.Actor
+	var ok bool
+	toStderr(func() {
+		person, ok = resolvePersonArg(client, "graph", args[0], args, finalRegion)
+	})
+	if !ok {
+		return
+	}
+
+	// The graph itself may go to stdout, so status messages go to stderr
+	fmt.Fprintf(os.Stderr, "Building the graph of %s (depth %d)...\n", person.Name, graphDepth)
+	if descriptions := filterConfig.Describe(); len(descriptions) > 0 {
+		fmt.Fprintf(os.Stderr, "Filters: %s\n", strings.Join(descriptions, " | "))
+	}
+
+	memo := newCreditsMemo(client, finalRegion, graphRequestBudget)
+	mp := processor.NewMovieProcessor(client, filterConfig)
+	// Details, certifications and keywords fetched by the filters count against the budget
+	mp.SetLookupHook(memo.Charge)
+
+	g, err := graph.Build(memo, graph.Person{ID: person.ID, Name: person.Name}, graph.BuildOptions{
+		Depth:              graphDepth,
+		Bipartite:          graphMode == "bipartite",
+		MaxMoviesPerPerson: graphMaxMoviesPerPerson,
+		MaxCastPerMovie:    graphMaxCastPerMovie,
+		Allow: func(movie models.Movie) (bool, error) {
+			allowed := mp.MatchesMovie(&movie)
+			return allowed, mp.LookupErr()
+		},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error building graph: %v\n", err)
+		return
+	}
+
+	var w io.Writer = os.Stdout
+	if graphOutput != "" {
+		file, err := os.Create(graphOutput)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if err := graph.Write(w, g, format); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return
+	}
+
+	if g.Truncated {
+		fmt.Fprintf(os.Stderr, "The request budget of %d ran out, the graph is incomplete. Try a larger --budget.\n", graphRequestBudget)
+	}
+	fmt.Fprintf(os.Stderr, "Exported %d nodes and %d edges (%d requests).\n", len(g.Nodes), len(g.Edges), memo.Requests)
+}
+
+// toStderr runs fn with stdout redirected to stderr, for shared helpers that print
+// status messages while stdout carries the graph
+func toStderr(fn func()) {
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+	defer func() { os.Stdout = stdout }()
+	fn()
+}
@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/spf13/cobra"
+)
+
+var keywordFlags = MovieCommandFlags{}
+
+var (
+	keywordList bool
+)
+
+var keywordCmd = &cobra.Command{
+	Use:   "keyword [name]",
+	Short: "Find movies tagged with a keyword on your streaming providers.",
+	Long: `Looks up a TMDb keyword (e.g. "heist" or "time travel") and lists the best
+rated movies tagged with it that are available on your providers. Use --list
+to see all matching keywords and their IDs.
+
+Examples:
+  tmdb keyword "heist"
+  tmdb keyword "time travel" --exclude-keyword "based on novel or book"
+  tmdb keyword "heist" --list`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  runKeyword,
+}
+
+func init() {
+	keywordFlags.Register(keywordCmd, true)
+	keywordFlags.RegisterSeen(keywordCmd)
+	keywordFlags.RegisterFits(keywordCmd)
+	keywordFlags.RegisterSort(keywordCmd)
+	keywordCmd.Flags().BoolVar(&keywordList, "list", false, "List matching keywords instead of movies")
+}
+
+func runKeyword(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+	query := strings.Join(args, " ")
+
+	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, _ := keywordFlags.Resolve(cmd, cfg)
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	keywordResp, err := client.SearchKeyword(query)
+	if err != nil {
+		fmt.Printf("Error searching keywords: %v\n", err)
+		return
+	}
+
+	if len(keywordResp.Results) == 0 {
+		fmt.Printf("No keywords found for \"%s\"\n", query)
+		return
+	}
+
+	if keywordList {
+		for _, k := range keywordResp.Results {
+			fmt.Printf("%8d  %s\n", k.ID, k.Name)
+		}
+		fmt.Printf("\nFound %d keywords. Use a name or ID with --keyword on any list command.\n", len(keywordResp.Results))
+		return
+	}
+
+	keyword, err := filters.BestKeywordMatch(query, keywordResp.Results)
+	if err != nil {
+		fmt.Printf("Error: %v (see --list)\n", err)
+		return
+	}
+
+	genreList, genreMap := LoadGenres(client)
+
+	filterConfig, err := keywordFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("🔑 Keyword: %s (ID %d)\n", display.HighlightStyle.Render(keyword.Name), keyword.ID)
+	if len(keywordResp.Results) > 1 {
+		fmt.Printf("   %d more matching keywords, see: tmdb keyword \"%s\" --list\n", len(keywordResp.Results)-1, query)
+	}
+	fmt.Println()
+
+	display.PrintSearchStartMessage("Movies tagged \""+keyword.Name+"\"", finalMinRating, finalMinVotes, finalProviders, finalRegion)
+	display.PrintActiveFilters(filterConfig.Describe())
+
+	// Additional --keyword filters are still checked per movie by the processor
+	discoverParams := filterConfig.DiscoverParams("vote_average.desc")
+	discoverParams.WithKeywords = strconv.Itoa(keyword.ID)
+
+	runDiscoverList(client, filterConfig, discoverParams, "movies tagged \""+keyword.Name+"\"")
+}
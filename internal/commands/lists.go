@@ -0,0 +1,171 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/store"
+)
+
+// parseListMediaType validates the --type of the list commands, "" for movies and shows
+func parseListMediaType(value string) (string, error) {
+	media, err := filters.ParseMedia(value)
+	if err != nil || media == "all" {
+		return "", err
+	}
+	return media, nil
+}
+
+// resolveTitle turns the argument of `watchlist add` or `seen add` into a title: a TMDb
+// ID with --id, the number of a result of the last list, or a title to search for.
+// A number that is not a result of the last list (or without one) is a TMDb ID.
+func resolveTitle(client *api.Client, arg string, byID bool, mediaType, region string) (store.Item, error) {
+	languageCode := strings.ToLower(region) + "-" + strings.ToUpper(region)
+	number, numErr := strconv.Atoi(arg)
+
+	if byID {
+		if numErr != nil {
+			return store.Item{}, fmt.Errorf("invalid TMDb ID: %s", arg)
+		}
+		return lookupTitle(client, number, mediaType, languageCode)
+	}
+
+	if numErr == nil {
+		item, found, err := lastResult(number, mediaType)
+		if err != nil || found {
+			return item, err
+		}
+		return lookupTitle(client, number, mediaType, languageCode)
+	}
+
+	searchResp, err := client.SearchMulti(arg, languageCode, false)
+	if err != nil {
+		return store.Item{}, fmt.Errorf("searching: %w", err)
+	}
+	var matches []models.MultiSearchResult
+	for _, result := range searchResp.Results {
+		if result.MediaType == models.MediaTypePerson || (mediaType != "" && result.MediaType != mediaType) {
+			continue
+		}
+		matches = append(matches, result)
+	}
+	if len(matches) == 0 {
+		return store.Item{}, fmt.Errorf("no movie or show found matching '%s'", arg)
+	}
+
+	// Prefer an exact title match over the most relevant search result
+	match := matches[0]
+	for _, result := range matches {
+		if strings.EqualFold(result.GetTitle(), arg) {
+			match = result
+			break
+		}
+	}
+	return store.Item{
+		ID:        match.ID,
+		MediaType: match.MediaType,
+		Title:     match.GetTitle(),
+		Year:      strings.Trim(match.GetYear(), "()"),
+		AddedAt:   time.Now(),
+	}, nil
+}
+
+// lookupTitle fetches the title of a movie or show by TMDb ID
+func lookupTitle(client *api.Client, id int, mediaType, languageCode string) (store.Item, error) {
+	if mediaType == models.MediaTypeTV {
+		show, err := client.GetShow(id, languageCode)
+		if err != nil {
+			return store.Item{}, fmt.Errorf("fetching show %d: %w", id, err)
+		}
+		movie := models.Movie{ID: show.ID, Name: show.Name, FirstAirDate: show.FirstAirDate, MediaType: models.MediaTypeTV}
+		return store.NewItem(movie, time.Now()), nil
+	}
+
+	movie, err := client.GetMovieDetails(id, languageCode)
+	if err != nil {
+		return store.Item{}, fmt.Errorf("fetching movie %d: %w", id, err)
+	}
+	return store.NewItem(*movie, time.Now()), nil
+}
+
+// lastResult returns a result of the last displayed list by its number. found is false
+// if there is no last list or the number is not in it.
+func lastResult(number int, mediaType string) (item store.Item, found bool, err error) {
+	path, err := store.ResultsPath()
+	if err != nil {
+		return store.Item{}, false, err
+	}
+	results, err := store.LoadResults(path)
+	if err != nil {
+		return store.Item{}, false, err
+	}
+
+	matches := display.ResultsByNumber(results, number, mediaType)
+	switch {
+	case len(matches) == 0:
+		return store.Item{}, false, nil
+	case len(matches) > 1:
+		return store.Item{}, false, fmt.Errorf("result %d of the last list is ambiguous, use --type movie or --type tv (or --id for a TMDb ID)", number)
+	case matches[0].MediaType == models.MediaTypePerson:
+		return store.Item{}, false, fmt.Errorf("result %d of the last list is a person (use --id for a TMDb ID)", number)
+	}
+
+	result := matches[0]
+	return store.Item{ID: result.ID, MediaType: result.MediaType, Title: result.Title, Year: result.Year, AddedAt: time.Now()}, true, nil
+}
+
+// findListItem finds the title to remove by its number in the `ls` output, its TMDb
+// ID with --id, or its title. name describes the list in errors, e.g. "watchlist".
+func findListItem(list *store.List, name, arg string, byID bool, mediaType string) (int, error) {
+	number, numErr := strconv.Atoi(arg)
+
+	switch {
+	case byID:
+		if numErr != nil {
+			return -1, fmt.Errorf("invalid TMDb ID: %s", arg)
+		}
+		for i, item := range list.Items {
+			if item.ID == number && (mediaType == "" || item.MediaType == mediaType) {
+				return i, nil
+			}
+		}
+		return -1, fmt.Errorf("TMDb ID %d is not on your %s", number, name)
+	case numErr == nil:
+		if number < 1 || number > len(list.Items) {
+			return -1, fmt.Errorf("invalid number: %d (your %s has %d titles)", number, name, len(list.Items))
+		}
+		return number - 1, nil
+	}
+
+	for i, item := range list.Items {
+		if strings.EqualFold(item.Title, arg) && (mediaType == "" || item.MediaType == mediaType) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("'%s' is not on your %s", arg, name)
+}
+
+func listItemDisplay(number int, item store.Item) display.ListItemDisplay {
+	return display.ListItemDisplay{
+		Number:    number,
+		MediaType: item.MediaType,
+		Title:     item.Title,
+		Year:      item.Year,
+		TmdbID:    item.ID,
+		AddedAt:   item.AddedAt,
+		Rating:    item.Rating,
+	}
+}
+
+func itemLabel(item store.Item) string {
+	if item.Year == "" {
+		return item.Title
+	}
+	return fmt.Sprintf("%s (%s)", item.Title, item.Year)
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// LoadKeywords resolves comma-separated keyword names or IDs into a keyword filter.
+// Unlike genres, keywords are looked up one by one via /search/keyword.
+func LoadKeywords(client *api.Client, include, exclude string) (filters.KeywordFilter, error) {
+	includeKeywords, err := resolveKeywords(client, filters.SplitNames(include))
+	if err != nil {
+		return filters.KeywordFilter{}, err
+	}
+
+	excludeKeywords, err := resolveKeywords(client, filters.SplitNames(exclude))
+	if err != nil {
+		return filters.KeywordFilter{}, err
+	}
+
+	return filters.KeywordFilter{Include: includeKeywords, Exclude: excludeKeywords}, nil
+}
+
+func resolveKeywords(client *api.Client, names []string) ([]models.Keyword, error) {
+	keywords := []models.Keyword{}
+	for _, name := range names {
+		if id, err := strconv.Atoi(name); err == nil {
+			keywords = append(keywords, models.Keyword{ID: id, Name: name})
+			continue
+		}
+
+		resp, err := client.SearchKeyword(name)
+		if err != nil {
+			return nil, fmt.Errorf("looking up keyword %q: %w", name, err)
+		}
+		keyword, err := filters.BestKeywordMatch(name, resp.Results)
+		if err != nil {
+			return nil, fmt.Errorf("%w (try 'tmdb keyword %q --list')", err, name)
+		}
+		keywords = append(keywords, keyword)
+	}
+	return keywords, nil
+}
+
+// LoadCompanies resolves comma-separated company names or IDs via /search/company
+func LoadCompanies(client *api.Client, input string) ([]models.Company, error) {
+	companies := []models.Company{}
+	for _, name := range filters.SplitNames(input) {
+		if id, err := strconv.Atoi(name); err == nil {
+			companies = append(companies, models.Company{ID: id, Name: name})
+			continue
+		}
+
+		resp, err := client.SearchCompany(name)
+		if err != nil {
+			return nil, fmt.Errorf("looking up company %q: %w", name, err)
+		}
+		company, err := filters.BestCompanyMatch(name, resp.Results)
+		if err != nil {
+			return nil, fmt.Errorf("%w (try 'tmdb company %q --list')", err, name)
+		}
+		companies = append(companies, company)
+	}
+	return companies, nil
+}
+
+// LoadNetworks resolves comma-separated network names or IDs. TMDB can't search
+// networks, so names are limited to well-known networks; others need their ID.
+func LoadNetworks(client *api.Client, input string) ([]models.Network, error) {
+	networks := []models.Network{}
+	for _, name := range filters.SplitNames(input) {
+		id, err := strconv.Atoi(name)
+		if err != nil {
+			var ok bool
+			if id, ok = models.KnownNetworkID(name); !ok {
+				known := models.KnownNetworkNames()
+				sort.Strings(known)
+				return nil, fmt.Errorf("unknown network %q: use a TMDB network ID or one of: %s", name, strings.Join(known, ", "))
+			}
+		}
+
+		network, err := client.GetNetwork(id)
+		if err != nil {
+			return nil, fmt.Errorf("looking up network %q: %w", name, err)
+		}
+		networks = append(networks, *network)
+	}
+	return networks, nil
+}
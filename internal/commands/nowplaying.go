@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/processor"
+	"github.com/spf13/cobra"
+)
+
+var nowPlayingFlags = MovieCommandFlags{}
+
+var (
+	nowPlayingStreamingOnly bool
+)
+
+var nowPlayingCmd = &cobra.Command{
+	Use:   "now-playing",
+	Short: "List movies currently in theaters in your region.",
+	Long: `Queries TMDb's Now Playing list for your region and shows the
+region-specific release dates (theatrical, digital, physical).
+
+The provider filter is skipped unless --streaming-only is set. Rating and
+vote thresholds are only applied when passed explicitly.
+
+Examples:
+  tmdb now-playing
+  tmdb now-playing --region US
+  tmdb now-playing --streaming-only --providers Netflix`,
+	Run: runNowPlaying,
+}
+
+func init() {
+	nowPlayingFlags.Register(nowPlayingCmd, true)
+	nowPlayingFlags.RegisterSeen(nowPlayingCmd)
+	nowPlayingCmd.Flags().BoolVar(&nowPlayingStreamingOnly, "streaming-only", false, "Only show movies already streaming on your providers")
+}
+
+func runNowPlaying(cmd *cobra.Command, args []string) {
+	runReleaseList(cmd, &nowPlayingFlags, nowPlayingStreamingOnly, "Now Playing Movies", "movies now playing",
+		func(client *api.Client, region string) processor.FetchFunc {
+			return func(page int) (*models.DiscoverResponse, error) {
+				return client.GetNowPlayingMovies(page, region, region)
+			}
+		})
+}
@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/graph"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var pathFlags = MovieCommandFlags{}
+
+// Path search limits: every person expands their most popular movies only, following
+// the top billed cast, so a search of depth 6 stays within a few hundred requests
+const (
+	pathMaxDepth           = 6
+	pathBudget             = 500
+	pathMaxMoviesPerPerson = 30
+	pathMaxCastPerMovie    = 15
+)
+
+var (
+	pathDepth         int
+	pathRequestBudget int
+	pathStreamingOnly bool
+)
+
+var pathCmd = &cobra.Command{
+	Use:   "path [name] [name]",
+	Short: "Find the shortest co-star path between two people (six degrees).",
+	Long: `Searches the shortest chain of movies connecting two people through their
+co-stars, e.g. Kevin Bacon and Nicolas Cage, with a bidirectional breadth-first
+search over the movie credits.
+
+Each person's most popular movies and their top billed cast are followed. The
+search gives up after --max-depth movies or --budget API requests. With
+--streaming-only every movie of the chain must be available on your providers.
+
+If a name matches several people, pick one by appending its index, e.g. "Tom:1".
+
+Examples:
+  tmdb path "Kevin Bacon" "Nicolas Cage"
+  tmdb path "Kevin Bacon" "Nicolas Cage" --streaming-only
+  tmdb path "Tom:1" "Meg Ryan" --max-depth 3 --budget 200`,
+	Args: cobra.ExactArgs(2),
+	Run:  runPath,
+}
+
+func init() {
+	pathFlags.RegisterBasic(pathCmd)
+	pathCmd.Flags().IntVar(&pathDepth, "max-depth", pathMaxDepth, "Maximum number of movies in the chain")
+	pathCmd.Flags().IntVar(&pathRequestBudget, "budget", pathBudget, "Maximum number of API requests, 0 for no limit")
+	pathCmd.Flags().BoolVar(&pathStreamingOnly, "streaming-only", false, "Only use movies available on your providers")
+}
+
+func runPath(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+
+	finalRegion, finalProviders, _, _, finalTimeout, _ := pathFlags.Resolve(cmd, cfg)
+	desiredProviders := filters.ParseProviders(finalProviders)
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var people []graph.Person
+	for _, arg := range args {
+		person, ok := resolvePersonArg(client, "path", arg, args, finalRegion)
+		if !ok {
+			return
+		}
+		people = append(people, graph.Person{ID: person.ID, Name: person.Name})
+	}
+
+	memo := newCreditsMemo(client, finalRegion, pathRequestBudget)
+	providers := make(map[int][]string)
+
+	opts := graph.Options{
+		MaxDepth:           pathDepth,
+		MaxMoviesPerPerson: pathMaxMoviesPerPerson,
+		MaxCastPerMovie:    pathMaxCastPerMovie,
+	}
+	if pathStreamingOnly {
+		opts.Allow = func(movie models.Movie) (bool, error) {
+			if available, ok := providers[movie.ID]; ok {
+				return len(available) > 0, nil
+			}
+			if err := memo.Charge(); err != nil {
+				return false, err
+			}
+			providerData, err := client.GetWatchProviders(movie.ID, finalRegion)
+			if err != nil {
+				providers[movie.ID] = nil
+				return false, nil
+			}
+			available, _ := filters.CheckAvailability(providerData, desiredProviders)
+			providers[movie.ID] = available
+			return len(available) > 0, nil
+		}
+	}
+
+	fmt.Printf("Searching a path from %s to %s (max. %d movies)...\n\n",
+		display.TitleStyle.Render(people[0].Name), display.TitleStyle.Render(people[1].Name), pathDepth)
+
+	path, found, err := graph.FindPath(memo, people[0], people[1], opts)
+	if errors.Is(err, graph.ErrBudgetExceeded) {
+		fmt.Printf("No path found within the budget of %d requests. Try a larger --budget.\n", pathRequestBudget)
+		return
+	}
+	if err != nil {
+		fmt.Printf("Error searching path: %v\n", err)
+		return
+	}
+	if !found {
+		fmt.Printf("No path found within %d movies (%d requests).\n", pathDepth, memo.Requests)
+		return
+	}
+
+	// Look up availability of the chain's movies not checked during the search
+	for _, movie := range path.Movies {
+		if _, ok := providers[movie.ID]; ok {
+			continue
+		}
+		if providerData, err := client.GetWatchProviders(movie.ID, finalRegion); err == nil {
+			providers[movie.ID], _ = filters.CheckAvailability(providerData, desiredProviders)
+		}
+	}
+
+	display.DisplayPath(display.PathDisplay{Path: path, Providers: providers})
+	fmt.Printf("Degrees of separation: %d (found with %d requests)\n", len(path.Movies), memo.Requests)
+}
+
+// newCreditsMemo creates a memo over the movie credits of people and the cast of movies
+func newCreditsMemo(client *api.Client, language string, budget int) *graph.Memo {
+	return graph.NewMemo(
+		func(personID int) ([]models.Movie, error) {
+			credits, err := client.GetActorCredits(personID, language)
+			if err != nil {
+				return nil, err
+			}
+			return credits.Cast, nil
+		},
+		func(movieID int) ([]models.CastMember, error) {
+			credits, err := client.GetMovieCredits(movieID, language)
+			if err != nil {
+				return nil, err
+			}
+			return credits.Cast, nil
+		},
+		budget,
+	)
+}
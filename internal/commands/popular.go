@@ -3,10 +3,8 @@ package commands
 import (
 	"fmt"
 
-	"github.com/sebastianneubert/tmdb/internal/api"
 	"github.com/sebastianneubert/tmdb/internal/config"
 	"github.com/sebastianneubert/tmdb/internal/display"
-	"github.com/sebastianneubert/tmdb/internal/filters"
 	"github.com/sebastianneubert/tmdb/internal/models"
 	"github.com/sebastianneubert/tmdb/internal/processor"
 	"github.com/spf13/cobra"
@@ -23,45 +21,55 @@ var popularCmd = &cobra.Command{
 
 func init() {
 	popularFlags.Register(popularCmd, true)
+	popularFlags.RegisterSeen(popularCmd)
+	popularFlags.RegisterFits(popularCmd)
+	popularFlags.RegisterSort(popularCmd)
 }
 
 func runPopular(cmd *cobra.Command, args []string) {
 	cfg := config.Get()
 
-	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, popularGenre := popularFlags.Resolve(cmd, cfg)
+	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, _ := popularFlags.Resolve(cmd, cfg)
 
-	client, err := api.NewClient(cfg.APIKey, finalTimeout)
+	client, err := newClient(cfg.APIKey, finalTimeout)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		return
 	}
 
-	desiredProviders := filters.ParseProviders(finalProviders)
 	genreList, genreMap := LoadGenres(client)
 
+	filterConfig, err := popularFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
 	display.PrintSearchStartMessage("Popular Movies", finalMinRating, finalMinVotes, finalProviders, finalRegion)
+	display.PrintActiveFilters(filterConfig.Describe())
 
-	processor := processor.NewMovieProcessor(client, processor.FilterConfig{
-		MinRating:        finalMinRating,
-		MinVotes:         finalMinVotes,
-		Region:           finalRegion,
-		GenreFilter:      popularGenre,
-		DesiredProviders: desiredProviders,
-		GenreList:        genreList,
-		GenreMap:         genreMap,
-	})
+	fetch := func(page int) (*models.DiscoverResponse, error) {
+		return client.GetPopularMovies(page, finalRegion)
+	}
+	if filterConfig.NeedsDiscover() {
+		// Push the filters down so the pages we scan already match
+		discoverParams := filterConfig.DiscoverParams("popularity.desc")
+		fetch = func(page int) (*models.DiscoverResponse, error) {
+			return client.DiscoverMovies(page, finalRegion, discoverParams)
+		}
+	}
+
+	processor := processor.NewMovieProcessor(client, filterConfig)
 
 	fetcher := display.NewDetailsFetcher(client, finalRegion, genreList)
-	resultsFound := 0
+	collector := newMovieCollector(filterConfig)
 
 	err = processor.Process(
-		func(page int) (*models.DiscoverResponse, error) {
-			return client.GetPopularMovies(page, finalRegion)
-		},
+		fetch,
 		func(movie *models.Movie, providers []string, genres []string) error {
-			resultsFound++
-			movieDisplay := fetcher.BuildMovieDisplay(resultsFound, movie, providers, genres)
-			display.DisplayMovie(movieDisplay)
+			collector.Add(movie, func(number int) display.MovieDisplay {
+				return fetcher.BuildMovieDisplay(number, movie, providers, genres)
+			})
 			return nil
 		},
 	)
@@ -71,5 +79,7 @@ func runPopular(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	resultsFound := collector.Flush()
+
 	display.PrintSearchResultsSummary("popular movies", resultsFound)
 }
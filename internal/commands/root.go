@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/sebastianneubert/tmdb/internal/api"
 	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/store"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -28,6 +31,20 @@ func init() {
 	rootCmd.AddCommand(showsCmd)
 	rootCmd.AddCommand(searchCmd)
 	rootCmd.AddCommand(genresCmd)
+	rootCmd.AddCommand(upcomingCmd)
+	rootCmd.AddCommand(nowPlayingCmd)
+	rootCmd.AddCommand(airingCmd)
+	rootCmd.AddCommand(findCmd)
+	rootCmd.AddCommand(gemsCmd)
+	rootCmd.AddCommand(keywordCmd)
+	rootCmd.AddCommand(companyCmd)
+	rootCmd.AddCommand(togetherCmd)
+	rootCmd.AddCommand(pathCmd)
+	rootCmd.AddCommand(graphCmd)
+	rootCmd.AddCommand(tuiCmd)
+	rootCmd.AddCommand(shellCmd)
+	rootCmd.AddCommand(watchlistCmd)
+	rootCmd.AddCommand(seenCmd)
 }
 
 func Execute() {
@@ -36,11 +53,39 @@ func Execute() {
 	bindCommandFlags(actorCmd)
 	bindCommandFlags(searchCmd)
 	bindCommandFlags(showsCmd)
+	bindCommandFlags(upcomingCmd)
+	bindCommandFlags(nowPlayingCmd)
+	bindCommandFlags(airingCmd)
+	bindCommandFlags(findCmd)
+	bindCommandFlags(gemsCmd)
+	bindCommandFlags(keywordCmd)
+	bindCommandFlags(companyCmd)
+	bindCommandFlags(togetherCmd)
+	bindCommandFlags(pathCmd)
+	bindCommandFlags(graphCmd)
+	bindCommandFlags(tuiCmd)
+	bindCommandFlags(shellCmd)
+	bindCommandFlags(watchlistAddCmd)
+	bindCommandFlags(watchlistCheckCmd)
+	bindCommandFlags(seenAddCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+	rememberResults()
+}
+
+// rememberResults saves the numbered results of the list just displayed, so the next
+// command can refer to them, e.g. `tmdb watchlist add 3`
+func rememberResults() {
+	results := display.LastResults()
+	if len(results) == 0 {
+		return
+	}
+	if path, err := store.ResultsPath(); err == nil {
+		store.SaveResults(path, results)
+	}
 }
 
 func bindCommandFlags(cmd *cobra.Command) {
@@ -50,3 +95,14 @@ func bindCommandFlags(cmd *cobra.Command) {
 	viper.BindPFlag("MIN_VOTES", cmd.Flags().Lookup("min-votes"))
 	viper.BindPFlag("API_TIMEOUT_SECONDS", cmd.Flags().Lookup("timeout"))
 }
+
+// sessionClient is shared by all commands run in an interactive shell
+var sessionClient *api.Client
+
+// newClient returns the shell's shared client, or a new client outside the shell
+func newClient(apiKey string, timeout int) (*api.Client, error) {
+	if sessionClient != nil {
+		return sessionClient, nil
+	}
+	return api.NewClient(apiKey, timeout)
+}
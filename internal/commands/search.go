@@ -4,10 +4,11 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/sebastianneubert/tmdb/internal/api"
 	"github.com/sebastianneubert/tmdb/internal/config"
 	"github.com/sebastianneubert/tmdb/internal/display"
 	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/processor"
 	"github.com/spf13/cobra"
 )
 
@@ -32,6 +33,9 @@ Examples:
 
 func init() {
 	searchFlags.Register(searchCmd, true)
+	searchFlags.RegisterSeen(searchCmd)
+	searchFlags.RegisterFits(searchCmd)
+	searchFlags.RegisterSort(searchCmd)
 	searchCmd.Flags().IntVar(&searchMaxResults, "max", 20, "Maximum results to display")
 }
 
@@ -39,22 +43,29 @@ func runSearch(cmd *cobra.Command, args []string) {
 	cfg := config.Get()
 	query := strings.Join(args, " ")
 
-	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, searchGenre := searchFlags.Resolve(cmd, cfg)
+	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, _ := searchFlags.Resolve(cmd, cfg)
 
-	client, err := api.NewClient(cfg.APIKey, finalTimeout)
+	client, err := newClient(cfg.APIKey, finalTimeout)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		return
 	}
 
-	desiredProviders := filters.ParseProviders(finalProviders)
 	genreList, genreMap := LoadGenres(client)
 
+	filterConfig, err := searchFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
 	fmt.Printf("🔍 Searching for: \"%s\"\n", query)
 	fmt.Printf("Criteria: Min Rating: %.1f | Min Votes: %d\n", finalMinRating, finalMinVotes)
 	fmt.Printf("Filtering for [%s] in region [%s]\n\n", finalProviders, strings.ToUpper(finalRegion))
+	display.PrintActiveFilters(filterConfig.Describe())
 
-	searchResp, err := client.SearchMovie(query, "de-DE", finalRegion)
+	languageCode := strings.ToLower(finalRegion) + "-" + strings.ToUpper(finalRegion)
+	searchResp, err := client.SearchMovie(query, languageCode, finalRegion, !cfg.KidsMode)
 	if err != nil {
 		fmt.Printf("Error searching: %v\n", err)
 		return
@@ -67,45 +78,50 @@ func runSearch(cmd *cobra.Command, args []string) {
 
 	fmt.Printf("Found %d movies, filtering...\n\n", len(searchResp.Results))
 
+	mp := processor.NewMovieProcessor(client, filterConfig)
 	fetcher := display.NewDetailsFetcher(client, finalRegion, genreList)
-	resultsFound := 0
+	collector := newMovieCollector(filterConfig)
+	matches := 0
 
 	for _, movie := range searchResp.Results {
-		if resultsFound >= searchMaxResults {
+		if matches >= searchMaxResults {
 			break
 		}
 
 		// Use processor pattern for filtering
 		m := movie
 
-		// Apply rating and vote filters
-		if !filters.MeetsRatingCriteria(m.VoteAverage, m.VoteCount, finalMinRating, finalMinVotes) {
+		if filterConfig.Seen.Contains(models.MediaTypeMovie, m.ID) {
 			continue
 		}
 
-		// Check streaming availability
-		providerData, err := client.GetWatchProviders(m.ID, finalRegion)
-		if err != nil {
+		// Apply rating, vote, genre and date filters
+		if !mp.MatchesMovie(&m) {
 			continue
 		}
 
-		availableProviders, isAvailable := filters.CheckAvailability(providerData, desiredProviders)
-		if !isAvailable {
+		// Check streaming availability
+		providerData, err := client.GetWatchProviders(m.ID, finalRegion)
+		if err != nil {
 			continue
 		}
 
-		if searchGenre != "" && !filters.FilterByGenre(&m, searchGenre, genreMap) {
+		availableProviders, isAvailable := filters.CheckAvailability(providerData, filterConfig.DesiredProviders)
+		if !isAvailable || !mp.MatchesMovieExpression(&m, availableProviders) {
 			continue
 		}
 
 		// Movie matches all criteria
-		resultsFound++
+		matches++
 
 		genreNames := filters.GetGenreNames(m.GenreIDs, genreList)
-		movieDisplay := fetcher.BuildMovieDisplaySimple(resultsFound, &m, availableProviders, genreNames)
-		display.DisplayMovie(movieDisplay)
+		collector.Add(&m, func(number int) display.MovieDisplay {
+			return fetcher.BuildMovieDisplaySimple(number, &m, availableProviders, genreNames)
+		})
 	}
 
+	resultsFound := collector.Flush()
+
 	if resultsFound == 0 {
 		display.PrintSearchNoResults(query, len(searchResp.Results), finalMinRating, finalMinVotes)
 	} else {
@@ -0,0 +1,176 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/store"
+	"github.com/spf13/cobra"
+)
+
+var seenAddFlags = MovieCommandFlags{}
+
+var (
+	seenByID   bool
+	seenType   string
+	seenRating float64
+)
+
+var seenCmd = &cobra.Command{
+	Use:   "seen",
+	Short: "Keep a history of the movies and shows you have seen, with your rating.",
+	Long: `Manages the seen history stored in $XDG_DATA_HOME/tmdb (~/.local/share/tmdb by default).
+
+Seen titles are left out of the results of list commands (top, popular, shows,
+...) and filmographies. Use --include-seen to list them anyway.
+
+Titles can be added by the number of a result of the last list any command
+displayed, by TMDb ID, or by title. A number not in the last list is taken as a
+TMDb ID; --id forces this. Use --type to pick movies or shows.
+
+Examples:
+  tmdb seen add "Inception" --rating 8
+  tmdb seen add 3
+  tmdb seen add 27205
+  tmdb seen add 1438 --id --type tv --rating 9.5
+  tmdb seen ls
+  tmdb seen rm "Inception"
+  tmdb top --include-seen`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var seenAddCmd = &cobra.Command{
+	Use:   "add [number|id|title]",
+	Short: "Mark a result of the last list, a TMDb ID or a title as seen.",
+	Args:  cobra.ExactArgs(1),
+	Run:   runSeenAdd,
+}
+
+var seenRmCmd = &cobra.Command{
+	Use:     "rm [number|title]",
+	Aliases: []string{"remove"},
+	Short:   "Remove a title from the seen history by its number, TMDb ID or title.",
+	Args:    cobra.ExactArgs(1),
+	Run:     runSeenRm,
+}
+
+var seenLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List the seen history.",
+	Args:    cobra.NoArgs,
+	Run:     runSeenLs,
+}
+
+func init() {
+	seenAddFlags.RegisterBasic(seenAddCmd)
+	seenAddCmd.Flags().Float64Var(&seenRating, "rating", 0, "Your rating from 1 to 10")
+	for _, cmd := range []*cobra.Command{seenAddCmd, seenRmCmd} {
+		cmd.Flags().BoolVar(&seenByID, "id", false, "Treat the argument as a TMDb ID")
+		cmd.Flags().StringVar(&seenType, "type", "all", "Titles to match: "+strings.Join(filters.Media, ", "))
+	}
+	seenCmd.AddCommand(seenAddCmd, seenRmCmd, seenLsCmd)
+}
+
+func runSeenAdd(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+
+	finalRegion, _, _, _, finalTimeout, _ := seenAddFlags.Resolve(cmd, cfg)
+
+	if seenRating != 0 && (seenRating < 1 || seenRating > 10) {
+		fmt.Printf("Invalid rating: %g. Please provide a rating from 1 to 10\n", seenRating)
+		return
+	}
+	mediaType, err := parseListMediaType(seenType)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	seen, err := store.OpenList(store.Seen)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	item, err := resolveTitle(client, args[0], seenByID, mediaType, finalRegion)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	item.Rating = seenRating
+
+	message := fmt.Sprintf("Marked %s as seen.", itemLabel(item))
+	if !seen.Add(item) {
+		if seenRating == 0 {
+			fmt.Printf("%s is already in your seen history.\n", itemLabel(item))
+			return
+		}
+		seen.Items[seen.Find(item.MediaType, item.ID)].Rating = seenRating
+		message = fmt.Sprintf("Updated your rating of %s.", itemLabel(item))
+	}
+	if err := seen.Save(); err != nil {
+		fmt.Printf("Error saving seen history: %v\n", err)
+		return
+	}
+	fmt.Println(message)
+}
+
+func runSeenRm(cmd *cobra.Command, args []string) {
+	mediaType, err := parseListMediaType(seenType)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	seen, err := store.OpenList(store.Seen)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	i, err := findListItem(seen, "seen history", args[0], seenByID, mediaType)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	item := seen.Items[i]
+	seen.Remove(item.MediaType, item.ID)
+	if err := seen.Save(); err != nil {
+		fmt.Printf("Error saving seen history: %v\n", err)
+		return
+	}
+	fmt.Printf("Removed %s from your seen history.\n", itemLabel(item))
+}
+
+func runSeenLs(cmd *cobra.Command, args []string) {
+	seen, err := store.OpenList(store.Seen)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if len(seen.Items) == 0 {
+		fmt.Println("Your seen history is empty. Add titles with: tmdb seen add <number|id|title> [--rating 8]")
+		return
+	}
+
+	for i, item := range seen.Items {
+		itemDisplay := listItemDisplay(i+1, item)
+		itemDisplay.DateLabel = "Seen"
+		display.DisplayListItem(itemDisplay)
+	}
+	display.DisplaySeparator()
+	fmt.Printf("%d titles in your seen history.\n", len(seen.Items))
+}
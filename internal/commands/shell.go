@@ -0,0 +1,324 @@
+package commands
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/store"
+	"github.com/sebastianneubert/tmdb/internal/tui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var shellFlags = MovieCommandFlags{}
+
+// shellBuiltins are the shell's own commands, next to all tmdb commands
+var shellBuiltins = []string{"set", "open", "exit", "quit"}
+
+var shellCmd = &cobra.Command{
+	Use:   "shell",
+	Short: "Run tmdb commands in an interactive shell.",
+	Long: `Starts an interactive shell that runs tmdb commands without the tmdb prefix.
+The API client, genre lists and responses are kept in memory, so repeated
+lookups are instant. ↑/↓ browse the history, tab completes commands and flags.
+
+Shell commands:
+  set                 show the session settings
+  set <name> <value>  change a setting for the session, e.g. set region US
+  open [type] <n>     show the details of result n of the last list; type
+                      (movie, tv or person) picks one if both a movie and a
+                      show are numbered n
+  exit, quit          leave the shell (or ctrl+d)
+
+Examples:
+  tmdb> top --genre Drama
+  tmdb> open 3
+  tmdb> set region US
+  tmdb> actor "Tom" 2`,
+	Args: cobra.NoArgs,
+	Run:  runShell,
+}
+
+func init() {
+	shellFlags.RegisterBasic(shellCmd)
+}
+
+func runShell(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+	if sessionClient != nil {
+		fmt.Println("Error: already running in a shell")
+		return
+	}
+
+	finalRegion, finalProviders, _, _, finalTimeout, _ := shellFlags.Resolve(cmd, cfg)
+	// Flags given to the shell become the session's settings
+	config.Set("region", finalRegion)
+	config.Set("providers", finalProviders)
+
+	client, err := api.NewClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	client.EnableCache()
+	sessionClient = client
+	defer func() { sessionClient = nil }()
+
+	LoadGenres(client)
+	LoadTVGenres(client)
+
+	s := &shell{client: client}
+	readLine := s.scanLines(os.Stdin)
+	if tui.IsInteractive() {
+		readLine = s.editLines()
+		fmt.Println("tmdb shell — type help for commands, exit to quit.")
+	}
+
+	for {
+		line, err := readLine()
+		if errors.Is(err, tui.ErrInterrupt) {
+			continue
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				fmt.Printf("Error: %v\n", err)
+			}
+			return
+		}
+		if !s.run(line) {
+			return
+		}
+	}
+}
+
+// shell runs the commands of an interactive session
+type shell struct {
+	client *api.Client
+	// results are the numbered titles of the last list displayed
+	results []display.Result
+}
+
+// editLines reads lines with the line editor, keeping the history in the data directory
+func (s *shell) editLines() func() (string, error) {
+	historyPath, _ := store.HistoryPath()
+	history, _ := store.LoadHistory(historyPath)
+	editor := &tui.LineEditor{Prompt: "tmdb> ", History: history, Complete: completeShell}
+
+	return func() (string, error) {
+		line, err := editor.ReadLine()
+		if err == nil && line != "" && historyPath != "" {
+			store.AppendHistory(historyPath, line)
+		}
+		return line, err
+	}
+}
+
+// scanLines reads commands from a pipe, e.g. `tmdb shell < commands.txt`
+func (s *shell) scanLines(r io.Reader) func() (string, error) {
+	scanner := bufio.NewScanner(r)
+	return func() (string, error) {
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return "", err
+			}
+			return "", io.EOF
+		}
+		return strings.TrimSpace(scanner.Text()), nil
+	}
+}
+
+// run runs a line, false if the shell should exit
+func (s *shell) run(line string) bool {
+	words, err := tui.SplitWords(line)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return true
+	}
+	if len(words) == 0 {
+		return true
+	}
+	if words[0] == "tmdb" {
+		words = words[1:]
+	}
+
+	switch words[0] {
+	case "exit", "quit":
+		return false
+	case "set":
+		s.set(words[1:])
+	case "open":
+		s.open(words[1:])
+	default:
+		s.execute(words)
+	}
+	return true
+}
+
+// execute runs a tmdb command and remembers the results it displayed
+func (s *shell) execute(args []string) {
+	if target, _, err := rootCmd.Find(args); err == nil {
+		if target.Name() == "shell" {
+			fmt.Println("Error: already running in a shell")
+			return
+		}
+		resetFlags(target)
+	}
+
+	display.ResetResults()
+	rootCmd.SetArgs(args)
+	rootCmd.Execute()
+	if results := display.LastResults(); len(results) > 0 {
+		s.results = results
+		rememberResults()
+	}
+}
+
+// resetFlags restores the defaults of a command's flags, which keep their values
+// from the previous run otherwise
+func resetFlags(cmd *cobra.Command) {
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if slice, ok := flag.Value.(pflag.SliceValue); ok {
+			slice.Replace(nil)
+		} else {
+			flag.Value.Set(flag.DefValue)
+		}
+		flag.Changed = false
+	})
+}
+
+func (s *shell) set(args []string) {
+	switch len(args) {
+	case 0:
+		cfg := config.Get()
+		fmt.Printf("region      %s\n", cfg.Region)
+		fmt.Printf("providers   %s\n", cfg.Providers)
+		fmt.Printf("min-rating  %.1f\n", cfg.MinRating)
+		fmt.Printf("min-votes   %d\n", cfg.MinVotes)
+		fmt.Printf("scoring     %s\n", cfg.Scoring)
+		fmt.Printf("kids-mode   %t\n", cfg.KidsMode)
+		if cfg.MaxCertification != "" {
+			fmt.Printf("max-certification %s\n", cfg.MaxCertification)
+		}
+		if cfg.Where != "" {
+			fmt.Printf("where       %s\n", cfg.Where)
+		}
+	case 1:
+		fmt.Println("Usage: set <name> <value>, e.g. set region US")
+	default:
+		if err := config.Set(args[0], strings.Join(args[1:], " ")); err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+	}
+}
+
+// open shows the details of a result of the last list
+func (s *shell) open(args []string) {
+	mediaType := ""
+	if len(args) == 2 {
+		mediaType = strings.ToLower(args[0])
+		args = args[1:]
+	}
+	if len(args) != 1 {
+		fmt.Println("Usage: open [movie|tv|person] <number>")
+		return
+	}
+	number, err := strconv.Atoi(args[0])
+	if err != nil || number < 1 {
+		fmt.Printf("Invalid number: %s. Please provide a positive number (1, 2, 3, ...)\n", args[0])
+		return
+	}
+
+	matches := display.ResultsByNumber(s.results, number, mediaType)
+	switch {
+	case len(matches) == 0:
+		fmt.Printf("No result %d in the last list.\n", number)
+		return
+	case len(matches) > 1:
+		fmt.Printf("Result %d is ambiguous, use open movie %d or open tv %d.\n", number, number, number)
+		return
+	}
+
+	s.openResult(matches[0])
+}
+
+func (s *shell) openResult(result display.Result) {
+	cfg := config.Get()
+	desiredProviders := filters.ParseProviders(cfg.Providers)
+	languageCode := strings.ToLower(cfg.Region) + "-" + strings.ToUpper(cfg.Region)
+
+	switch result.MediaType {
+	case models.MediaTypeMovie:
+		displayMovieDetails(s.client, result.ID, cfg.Region, desiredProviders)
+	case models.MediaTypeTV:
+		show, err := s.client.GetShow(result.ID, languageCode)
+		if err != nil {
+			fmt.Printf("Error fetching show details: %v\n", err)
+			return
+		}
+		displayShowDetails(s.client, *show, cfg.Region, desiredProviders)
+	case models.MediaTypePerson:
+		person, err := s.client.GetPersonDetails(result.ID, languageCode)
+		if err != nil {
+			fmt.Printf("Error fetching person details: %v\n", err)
+			return
+		}
+		resetFlags(actorCmd)
+		genreList, genreMap := LoadGenres(s.client)
+		filterConfig, err := actorFlags.FilterConfig(actorCmd, cfg, s.client, genreList, genreMap)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		filmography, err := newFilmography(actorCmd, cfg, s.client, &actorFlags, filterConfig, filters.RoleForDepartment(person.KnownForDepartment), "all")
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		filmography.Providers = cfg.Providers
+		displayActorFilmography(s.client, models.Actor{ID: person.ID, Name: person.Name}, filmography)
+	}
+}
+
+// completeShell completes commands, their flags and the shell's settings
+func completeShell(words []string, current string) []string {
+	var options []string
+	switch {
+	case len(words) == 0:
+		options = append(options, shellBuiltins...)
+		for _, cmd := range rootCmd.Commands() {
+			if cmd.IsAvailableCommand() && cmd.Name() != "shell" {
+				options = append(options, cmd.Name())
+			}
+		}
+	case words[0] == "set" && len(words) == 1:
+		options = config.VariableNames()
+	case words[0] == "open" && len(words) == 1:
+		options = []string{models.MediaTypeMovie, models.MediaTypeTV, models.MediaTypePerson}
+	case strings.HasPrefix(current, "-"):
+		if cmd, _, err := rootCmd.Find(words); err == nil {
+			cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+				options = append(options, "--"+flag.Name)
+			})
+		}
+	}
+
+	var candidates []string
+	for _, option := range options {
+		if strings.HasPrefix(option, current) {
+			candidates = append(candidates, option)
+		}
+	}
+	sort.Strings(candidates)
+	return candidates
+}
@@ -2,12 +2,12 @@ package commands
 
 import (
 	"fmt"
-	"strings"
 
-	"github.com/sebastianneubert/tmdb/internal/api"
 	"github.com/sebastianneubert/tmdb/internal/config"
 	"github.com/sebastianneubert/tmdb/internal/display"
 	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/processor"
 	"github.com/spf13/cobra"
 )
 
@@ -21,7 +21,9 @@ var showsCmd = &cobra.Command{
 }
 
 func init() {
-	showsFlags.Register(showsCmd, false) // Shows command doesn't have genre filter yet
+	showsFlags.Register(showsCmd, true)
+	showsFlags.RegisterSeen(showsCmd)
+	showsFlags.RegisterFits(showsCmd)
 }
 
 func runShows(cmd *cobra.Command, args []string) {
@@ -29,78 +31,61 @@ func runShows(cmd *cobra.Command, args []string) {
 
 	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, _ := showsFlags.Resolve(cmd, cfg)
 
-	client, err := api.NewClient(cfg.APIKey, finalTimeout)
+	client, err := newClient(cfg.APIKey, finalTimeout)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		return
 	}
 
-	desiredProviders := filters.ParseProviders(finalProviders)
+	genreList, genreMap := LoadTVGenres(client)
 
-	fmt.Printf("Searching TMDb's Top Rated TV Shows...\n")
-	fmt.Printf("Criteria: Min Rating: %.1f | Min Votes: %d\n", finalMinRating, finalMinVotes)
-	fmt.Printf("Filtering for [%s] in region [%s]\n\n", finalProviders, strings.ToUpper(finalRegion))
+	filterConfig, err := showsFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
 
-	resultsFound := 0
-	for page := 1; page <= config.MaxPagesToSearch && resultsFound < config.MaxResultsToDisplay; page++ {
-		fmt.Printf("Fetching page %d...\n", page)
+	display.PrintSearchStartMessage("Top Rated TV Shows", finalMinRating, finalMinVotes, finalProviders, finalRegion)
+	display.PrintActiveFilters(filterConfig.Describe())
 
-		resp, err := client.GetTopRatedShows(page, "de-DE")
-		if err != nil {
-			fmt.Printf("Warning: Failed to fetch page %d: %v\n", page, err)
-			continue
+	fetch := func(page int) (*models.ShowDiscoverResponse, error) {
+		return client.GetTopRatedShows(page, "de-DE")
+	}
+	if filterConfig.NeedsDiscover() {
+		// Push the filters down so the pages we scan already match
+		discoverParams := filterConfig.DiscoverParams("vote_average.desc")
+		fetch = func(page int) (*models.ShowDiscoverResponse, error) {
+			return client.DiscoverShows(page, "de-DE", discoverParams)
 		}
+	}
 
-		for _, show := range resp.Results {
-			if resultsFound >= config.MaxResultsToDisplay {
-				break
-			}
-
-			if !filters.MeetsRatingCriteria(show.VoteAverage, show.VoteCount, finalMinRating, finalMinVotes) {
-				continue
-			}
-
-			providerData, err := client.GetShowWatchProviders(show.ID, finalRegion)
-			if err != nil {
-				continue
-			}
+	processor := processor.NewMovieProcessor(client, filterConfig)
+	resultsFound := 0
 
-			availableProviders, isAvailable := filters.CheckAvailability(providerData, desiredProviders)
-			if !isAvailable {
-				continue
+	err = processor.ProcessShows(
+		fetch,
+		func(show *models.Show, providers []string) error {
+			fitsEpisodes := filters.EpisodesWithinBudget(show.GetEpisodeRuntime(), filterConfig.FitsBudget)
+			if filterConfig.FitsBudget > 0 && fitsEpisodes == 0 {
+				return nil
 			}
 
 			resultsFound++
-			externalIDs, _ := client.GetShowExternalIDs(show.ID)
-			englishTitle, _ := client.GetShowEnglishTitle(show.ID)
-			if englishTitle == "" {
-				englishTitle = show.OriginalName
+			showDisplay := buildShowDisplay(client, resultsFound, show, providers)
+			showDisplay.FitsEpisodes = fitsEpisodes
+			showDisplay.Genres = filters.GetGenreNames(show.GenreIDs, genreList)
+			if filterConfig.Scoring.Weighted {
+				showDisplay.WeightedRating = filterConfig.Scoring.WeightedRating(show.VoteAverage, show.VoteCount)
 			}
+			display.DisplayShow(showDisplay)
+			return nil
+		},
+	)
 
-			display.DisplayShow(display.ShowDisplay{
-				Number:       resultsFound,
-				Title:        show.GetTitle(),
-				EnglishTitle: englishTitle,
-				Year:         show.GetYear(),
-				Rating:       show.VoteAverage,
-				Votes:        show.VoteCount,
-				Providers:    availableProviders,
-				TmdbID:       show.ID,
-				ImdbID:       externalIDs.ImdbID,
-				TvdbID:       externalIDs.TvdbID,
-				Overview:     show.Overview,
-			})
-		}
-
-		if page >= resp.TotalPages {
-			break
-		}
+	if err != nil {
+		fmt.Printf("Error processing shows: %v\n", err)
+		return
 	}
 
-	display.DisplaySeparator()
-	if resultsFound == 0 {
-		fmt.Println("No TV shows found matching criteria.")
-	} else {
-		fmt.Printf("Displayed %d top-rated TV shows.\n", resultsFound)
-	}
+	display.PrintSearchResultsSummary("top-rated TV shows", resultsFound)
 }
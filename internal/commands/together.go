@@ -0,0 +1,213 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var togetherFlags = MovieCommandFlags{}
+
+var (
+	togetherMedia string
+)
+
+var togetherCmd = &cobra.Command{
+	Use:   "together [name] [name]...",
+	Short: "Find movies and shows two or more people made together.",
+	Long: `Intersects the filmographies (cast and crew) of two or more people and lists
+the shared titles available on your providers with each person's role.
+
+If a name matches several people, they are listed to choose from. Pick one
+by appending its index to the name, e.g. "Tom:1".
+
+Examples:
+  tmdb together "Tom Hanks" "Meg Ryan"
+  tmdb together "Martin Scorsese" "Robert De Niro" --media movie
+  tmdb together "Tom Hanks" "Meg Ryan" "Bill Pullman" --min-rating 6`,
+	Args: cobra.MinimumNArgs(2),
+	Run:  runTogether,
+}
+
+func init() {
+	togetherFlags.Register(togetherCmd, true)
+	togetherFlags.RegisterSeen(togetherCmd)
+	togetherFlags.RegisterFits(togetherCmd)
+	togetherFlags.RegisterSort(togetherCmd)
+	togetherCmd.Flags().StringVar(&togetherMedia, "media", "all", "Titles to list: "+strings.Join(filters.Media, ", "))
+}
+
+func runTogether(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+
+	finalRegion, finalProviders, _, _, finalTimeout, _ := togetherFlags.Resolve(cmd, cfg)
+
+	media, err := filters.ParseMedia(togetherMedia)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	genreList, genreMap := LoadGenres(client)
+
+	filterConfig, err := togetherFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	filmography, err := newFilmography(cmd, cfg, client, &togetherFlags, filterConfig, "any", media)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	people := make([]models.Actor, 0, len(args))
+	for _, arg := range args {
+		person, ok := resolvePersonArg(client, "together", arg, args, finalRegion)
+		if !ok {
+			return
+		}
+		fmt.Printf("Found: %s (TMDb ID: %d)\n", display.TitleStyle.Render(person.Name), person.ID)
+		people = append(people, person)
+	}
+	fmt.Printf("Fetching filmographies...\n\n")
+
+	filmographies := make([][]models.Movie, len(people))
+	for i, person := range people {
+		credits, err := client.GetCombinedCredits(person.ID, finalRegion)
+		if err != nil {
+			fmt.Printf("Error fetching filmography of %s: %v\n", person.Name, err)
+			return
+		}
+		filmographies[i] = filters.CreditsForRole(credits, "any")
+	}
+
+	shared := filters.SharedCredits(filmographies)
+	roles := make(map[string][]string, len(shared))
+	titles := make([]models.Movie, len(shared))
+	for i, credit := range shared {
+		titles[i] = credit.Title
+		for j, personCredit := range credit.Credits {
+			role := people[j].Name
+			if description := filters.CreditDescription(personCredit); description != "" {
+				role += " (" + description + ")"
+			}
+			key := credit.Title.MediaType + strconv.Itoa(credit.Title.ID)
+			roles[key] = append(roles[key], role)
+		}
+	}
+	movies, shows := filters.SplitCredits(titles, media)
+
+	names := togetherNames(people)
+	if len(movies) == 0 && len(shows) == 0 {
+		fmt.Printf("No shared titles found for %s.\n", names)
+		return
+	}
+
+	fmt.Printf("Shared titles: %d movies, %d shows\n", len(movies), len(shows))
+	fmt.Printf("Filtering with Min Rating: %.1f | Min Votes: %d\n", filterConfig.MinRating, filterConfig.MinVotes)
+	fmt.Printf("Checking [%s] in region [%s]\n\n", finalProviders, strings.ToUpper(finalRegion))
+	display.PrintActiveFilters(filterConfig.Describe())
+
+	moviesFound, showsFound := 0, 0
+	if len(movies) > 0 {
+		moviesFound, _ = displayFilmographyMovies(client, movies, filterConfig, func(movie *models.Movie, movieDisplay *display.MovieDisplay) {
+			movieDisplay.Roles = roles[movie.MediaType+strconv.Itoa(movie.ID)]
+		})
+	}
+	if len(shows) > 0 {
+		if len(movies) > 0 {
+			fmt.Printf("\n%s\n", display.HighlightStyle.Render("TV Shows"))
+		}
+		showsFound, _ = displayFilmographyShows(client, shows, filmography.Shows, func(credit *models.Movie, showDisplay *display.ShowDisplay) {
+			showDisplay.Roles = roles[credit.MediaType+strconv.Itoa(credit.ID)]
+		})
+	}
+
+	display.DisplaySeparator()
+	if moviesFound+showsFound == 0 {
+		fmt.Printf("None of the shared titles of %s meet the criteria.\n", names)
+		return
+	}
+	fmt.Printf("Found %d movies and %d shows with %s.\n", moviesFound, showsFound, names)
+}
+
+// resolvePersonArg searches for a person given as "name" or "name:index" in the arguments
+// of a command taking several people. Without an index, a single exact name match is
+// preferred over the other search results.
+func resolvePersonArg(client *api.Client, command, arg string, args []string, region string) (models.Actor, bool) {
+	name, index := splitPersonIndex(arg)
+
+	results, err := client.SearchActor(name, region)
+	if err != nil {
+		fmt.Printf("Error searching: %v\n", err)
+		return models.Actor{}, false
+	}
+	if len(results.Results) == 0 {
+		fmt.Printf("No actors found matching '%s'\n", name)
+		return models.Actor{}, false
+	}
+
+	if index < 0 {
+		var exact []models.Actor
+		for _, actor := range results.Results {
+			if strings.EqualFold(actor.Name, name) {
+				exact = append(exact, actor)
+			}
+		}
+		if len(exact) == 1 {
+			return exact[0], true
+		}
+	}
+
+	// Suggest the same command with the first match picked
+	hintArgs := make([]string, len(args))
+	for i, a := range args {
+		if a == arg {
+			a = name + ":1"
+		}
+		hintArgs[i] = fmt.Sprintf("%q", a)
+	}
+	hint := "To pick one, append its index to the name:\n  tmdb " + command + " " + strings.Join(hintArgs, " ")
+
+	return selectActor(results.Results, name, index, hint)
+}
+
+// splitPersonIndex splits "Tom:2" into the name and the 0-based index, -1 without an index
+func splitPersonIndex(arg string) (string, int) {
+	i := strings.LastIndex(arg, ":")
+	if i < 0 {
+		return arg, -1
+	}
+	index, err := strconv.Atoi(arg[i+1:])
+	if err != nil || index < 1 {
+		return arg, -1
+	}
+	return strings.TrimSpace(arg[:i]), index - 1
+}
+
+// togetherNames joins names as "A, B and C"
+func togetherNames(people []models.Actor) string {
+	names := make([]string, len(people))
+	for i, person := range people {
+		names[i] = person.Name
+	}
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
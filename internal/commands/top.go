@@ -3,10 +3,8 @@ package commands
 import (
 	"fmt"
 
-	"github.com/sebastianneubert/tmdb/internal/api"
 	"github.com/sebastianneubert/tmdb/internal/config"
 	"github.com/sebastianneubert/tmdb/internal/display"
-	"github.com/sebastianneubert/tmdb/internal/filters"
 	"github.com/sebastianneubert/tmdb/internal/models"
 	"github.com/sebastianneubert/tmdb/internal/processor"
 	"github.com/spf13/cobra"
@@ -23,45 +21,55 @@ var topCmd = &cobra.Command{
 
 func init() {
 	topFlags.Register(topCmd, true)
+	topFlags.RegisterSeen(topCmd)
+	topFlags.RegisterFits(topCmd)
+	topFlags.RegisterSort(topCmd)
 }
 
 func runTop(cmd *cobra.Command, args []string) {
 	cfg := config.Get()
 
-	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, topGenre := topFlags.Resolve(cmd, cfg)
+	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, _ := topFlags.Resolve(cmd, cfg)
 
-	client, err := api.NewClient(cfg.APIKey, finalTimeout)
+	client, err := newClient(cfg.APIKey, finalTimeout)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		return
 	}
 
-	desiredProviders := filters.ParseProviders(finalProviders)
 	genreList, genreMap := LoadGenres(client)
 
+	filterConfig, err := topFlags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
 	display.PrintSearchStartMessage("Top Rated Movies", finalMinRating, finalMinVotes, finalProviders, finalRegion)
+	display.PrintActiveFilters(filterConfig.Describe())
 
-	processor := processor.NewMovieProcessor(client, processor.FilterConfig{
-		MinRating:        finalMinRating,
-		MinVotes:         finalMinVotes,
-		Region:           finalRegion,
-		GenreFilter:      topGenre,
-		DesiredProviders: desiredProviders,
-		GenreList:        genreList,
-		GenreMap:         genreMap,
-	})
+	fetch := func(page int) (*models.DiscoverResponse, error) {
+		return client.GetTopRatedMovies(page, finalRegion)
+	}
+	if filterConfig.NeedsDiscover() {
+		// Push the filters down so the pages we scan already match
+		discoverParams := filterConfig.DiscoverParams("vote_average.desc")
+		fetch = func(page int) (*models.DiscoverResponse, error) {
+			return client.DiscoverMovies(page, finalRegion, discoverParams)
+		}
+	}
+
+	processor := processor.NewMovieProcessor(client, filterConfig)
 
 	fetcher := display.NewDetailsFetcher(client, finalRegion, genreList)
-	resultsFound := 0
+	collector := newMovieCollector(filterConfig)
 
 	err = processor.Process(
-		func(page int) (*models.DiscoverResponse, error) {
-			return client.GetTopRatedMovies(page, finalRegion)
-		},
+		fetch,
 		func(movie *models.Movie, providers []string, genres []string) error {
-			resultsFound++
-			movieDisplay := fetcher.BuildMovieDisplay(resultsFound, movie, providers, genres)
-			display.DisplayMovie(movieDisplay)
+			collector.Add(movie, func(number int) display.MovieDisplay {
+				return fetcher.BuildMovieDisplay(number, movie, providers, genres)
+			})
 			return nil
 		},
 	)
@@ -71,5 +79,7 @@ func runTop(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	resultsFound := collector.Flush()
+
 	display.PrintSearchResultsSummary("top-rated movies", resultsFound)
 }
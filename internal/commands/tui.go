@@ -0,0 +1,193 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/store"
+	"github.com/sebastianneubert/tmdb/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+var tuiFlags = MovieCommandFlags{}
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Browse movies and shows in a full-screen terminal UI.",
+	Long: `Opens a full-screen list/detail browser over the top rated, popular and
+trending titles, movie search and the filmography of a person.
+
+Filters apply live: tab moves between the list, the minimum rating slider, the
+provider toggles and the genre chips. Only titles streaming on an enabled provider
+are listed; toggle all providers off to ignore availability. More results are loaded while scrolling.
+Press b to bookmark the selected title into your local watchlist.
+
+Keys:
+  1-5      switch between Top, Popular, Trending, Search and Actor
+  /        search for a movie
+  ↑/↓ j/k  move through the list
+  tab      cycle focus between the list and the filter controls
+  ←/→      adjust the rating or move between toggles, space toggles
+  b        bookmark the selected title
+  q, esc   quit`,
+	Args: cobra.NoArgs,
+	Run:  runTUI,
+}
+
+func init() {
+	tuiFlags.RegisterBasic(tuiCmd)
+}
+
+func runTUI(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+
+	if !tui.IsInteractive() {
+		fmt.Println("Error: tmdb tui needs an interactive terminal")
+		return
+	}
+
+	finalRegion, finalProviders, _, _, finalTimeout, _ := tuiFlags.Resolve(cmd, cfg)
+	languageCode := strings.ToLower(finalRegion) + "-" + strings.ToUpper(finalRegion)
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	watchlist, err := store.OpenList(store.Watchlist)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	genreList, _ := LoadGenres(client)
+	tvGenreList, _ := LoadTVGenres(client)
+
+	browser := tui.NewBrowser(tuiSources(client, cfg, languageCode, finalRegion), providerNames(finalProviders), append(genreList, tvGenreList...))
+	browser.Providers = func(item models.Movie) (models.RegionProviders, error) {
+		if item.IsShow() {
+			return client.GetShowWatchProviders(item.ID, finalRegion)
+		}
+		return client.GetWatchProviders(item.ID, finalRegion)
+	}
+	browser.IsBookmarked = func(item models.Movie) bool {
+		return watchlist.Contains(item.GetMediaType(), item.ID)
+	}
+	browser.Bookmark = func(item models.Movie) (bool, error) {
+		if !watchlist.Add(store.NewItem(item, time.Now())) {
+			return false, nil
+		}
+		return true, watchlist.Save()
+	}
+
+	if err := tui.Run(browser); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+}
+
+// tuiSources returns the tabs of the browser
+func tuiSources(client *api.Client, cfg config.Config, languageCode, region string) []tui.Source {
+	return []tui.Source{
+		{
+			Name: "Top",
+			Load: func(_ string, page int) ([]models.Movie, int, error) {
+				return discoverPage(client.GetTopRatedMovies(page, languageCode))
+			},
+		},
+		{
+			Name: "Popular",
+			Load: func(_ string, page int) ([]models.Movie, int, error) {
+				return discoverPage(client.GetPopularMovies(page, languageCode))
+			},
+		},
+		{
+			Name: "Trending",
+			Load: func(_ string, page int) ([]models.Movie, int, error) {
+				items, totalPages, err := discoverPage(client.GetTrending(page, languageCode))
+				// Trending also lists people, which the browser cannot show
+				titles := items[:0]
+				for _, item := range items {
+					if item.MediaType == models.MediaTypeMovie || item.MediaType == models.MediaTypeTV {
+						titles = append(titles, item)
+					}
+				}
+				return titles, totalPages, err
+			},
+		},
+		{
+			Name:   "Search",
+			Prompt: "Search",
+			Load: func(query string, page int) ([]models.Movie, int, error) {
+				if page > 1 {
+					return nil, 1, nil
+				}
+				return discoverPage(client.SearchMovie(query, languageCode, region, !cfg.KidsMode))
+			},
+		},
+		{
+			Name:   "Actor",
+			Prompt: "Actor",
+			Load: func(query string, page int) ([]models.Movie, int, error) {
+				if page > 1 {
+					return nil, 1, nil
+				}
+				return actorTitles(client, query, languageCode)
+			},
+		},
+	}
+}
+
+// actorTitles loads the movies and shows of the most popular person matching the name
+func actorTitles(client *api.Client, name, languageCode string) ([]models.Movie, int, error) {
+	results, err := client.SearchActor(name, languageCode)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(results.Results) == 0 {
+		return nil, 1, fmt.Errorf("no person found for %q", name)
+	}
+
+	actor := results.Results[0]
+	for _, result := range results.Results[1:] {
+		if result.Popularity > actor.Popularity {
+			actor = result
+		}
+	}
+
+	credits, err := client.GetCombinedCredits(actor.ID, languageCode)
+	if err != nil {
+		return nil, 0, err
+	}
+	titles := credits.Cast
+	if len(titles) == 0 {
+		titles = credits.Crew
+	}
+	sort.SliceStable(titles, func(i, j int) bool {
+		return titles[i].Popularity > titles[j].Popularity
+	})
+	return titles, 1, nil
+}
+
+func discoverPage(response *models.DiscoverResponse, err error) ([]models.Movie, int, error) {
+	if err != nil {
+		return nil, 0, err
+	}
+	return response.Results, response.TotalPages, nil
+}
+
+// providerNames splits the providers flag into the names shown as toggles
+func providerNames(providers string) []string {
+	var names []string
+	for _, name := range strings.Split(providers, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/processor"
+	"github.com/spf13/cobra"
+)
+
+var upcomingFlags = MovieCommandFlags{}
+
+var (
+	upcomingStreamingOnly bool
+)
+
+var upcomingCmd = &cobra.Command{
+	Use:   "upcoming",
+	Short: "List movies coming to theaters in your region.",
+	Long: `Queries TMDb's Upcoming Movies list for your region and shows the
+region-specific release dates (theatrical, digital, physical).
+
+Upcoming titles are usually not streaming yet, so the provider filter is
+skipped unless --streaming-only is set. Rating and vote thresholds are only
+applied when passed explicitly.
+
+Examples:
+  tmdb upcoming
+  tmdb upcoming --region US --genre Horror
+  tmdb upcoming --streaming-only`,
+	Run: runUpcoming,
+}
+
+func init() {
+	upcomingFlags.Register(upcomingCmd, true)
+	upcomingFlags.RegisterSeen(upcomingCmd)
+	upcomingCmd.Flags().BoolVar(&upcomingStreamingOnly, "streaming-only", false, "Only show movies already streaming on your providers")
+}
+
+func runUpcoming(cmd *cobra.Command, args []string) {
+	runReleaseList(cmd, &upcomingFlags, upcomingStreamingOnly, "Upcoming Movies", "upcoming movies",
+		func(client *api.Client, region string) processor.FetchFunc {
+			return func(page int) (*models.DiscoverResponse, error) {
+				return client.GetUpcomingMovies(page, region, region)
+			}
+		})
+}
+
+// runReleaseList is shared by the upcoming and now-playing commands. Both show
+// region-specific release dates and do not filter by provider by default.
+func runReleaseList(cmd *cobra.Command, flags *MovieCommandFlags, streamingOnly bool, searchType, summary string, fetch func(*api.Client, string) processor.FetchFunc) {
+	cfg := config.Get()
+
+	finalRegion, finalProviders, finalMinRating, finalMinVotes, finalTimeout, _ := flags.Resolve(cmd, cfg)
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	genreList, genreMap := LoadGenres(client)
+
+	filterConfig, err := flags.FilterConfig(cmd, cfg, client, genreList, genreMap)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	filterConfig.SkipProviderFilter = !streamingOnly
+
+	// New releases rarely have enough votes yet, so only filter when asked to
+	if !cmd.Flags().Changed("min-rating") {
+		finalMinRating = 0
+		filterConfig.MinRating = 0
+	}
+	if !cmd.Flags().Changed("min-votes") {
+		finalMinVotes = 0
+		filterConfig.MinVotes = 0
+	}
+
+	display.PrintSearchStartMessage(searchType, finalMinRating, finalMinVotes, finalProviders, finalRegion)
+	display.PrintActiveFilters(filterConfig.Describe())
+
+	processor := processor.NewMovieProcessor(client, filterConfig)
+
+	fetcher := display.NewDetailsFetcher(client, finalRegion, genreList)
+	resultsFound := 0
+
+	err = processor.Process(
+		fetch(client, finalRegion),
+		func(movie *models.Movie, providers []string, genres []string) error {
+			resultsFound++
+			movieDisplay := fetcher.BuildMovieDisplay(resultsFound, movie, providers, genres)
+			movieDisplay.ReleaseRegion = finalRegion
+			if filterConfig.Scoring.Weighted {
+				movieDisplay.WeightedRating = filterConfig.Scoring.WeightedRating(movie.VoteAverage, movie.VoteCount)
+			}
+			if releases, err := client.GetReleaseDates(movie.ID); err == nil {
+				movieDisplay.Releases = releases.ForRegion(finalRegion)
+			}
+			display.DisplayMovie(movieDisplay)
+			return nil
+		},
+	)
+
+	if err != nil {
+		fmt.Printf("Error processing movies: %v\n", err)
+		return
+	}
+
+	display.PrintSearchResultsSummary(summary, resultsFound)
+}
@@ -0,0 +1,242 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/store"
+	"github.com/spf13/cobra"
+)
+
+var (
+	watchlistAddFlags   = MovieCommandFlags{}
+	watchlistCheckFlags = MovieCommandFlags{}
+)
+
+var (
+	watchlistByID bool
+	watchlistType string
+)
+
+var watchlistCmd = &cobra.Command{
+	Use:   "watchlist",
+	Short: "Keep a local watchlist of movies and shows and check where they stream.",
+	Long: `Manages a watchlist stored in $XDG_DATA_HOME/tmdb (~/.local/share/tmdb by default).
+
+Titles can be added by the number of a result of the last list any command
+displayed, by TMDb ID, or by title. A number not in the last list is taken as a
+TMDb ID; --id forces this. Use --type to pick movies or shows.
+
+Examples:
+  tmdb top --genre Drama
+  tmdb watchlist add 3
+  tmdb watchlist add "The Wire" --type tv
+  tmdb watchlist add 27205 --id
+  tmdb watchlist ls
+  tmdb watchlist check --region US
+  tmdb watchlist rm 2`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var watchlistAddCmd = &cobra.Command{
+	Use:   "add [number|id|title]",
+	Short: "Add a result of the last list, a TMDb ID or a title to the watchlist.",
+	Args:  cobra.ExactArgs(1),
+	Run:   runWatchlistAdd,
+}
+
+var watchlistRmCmd = &cobra.Command{
+	Use:     "rm [number|title]",
+	Aliases: []string{"remove"},
+	Short:   "Remove a title by its watchlist number, TMDb ID or title.",
+	Args:    cobra.ExactArgs(1),
+	Run:     runWatchlistRm,
+}
+
+var watchlistLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List the watchlist.",
+	Args:    cobra.NoArgs,
+	Run:     runWatchlistLs,
+}
+
+var watchlistCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Show where each watchlist title is currently streaming in your region.",
+	Args:  cobra.NoArgs,
+	Run:   runWatchlistCheck,
+}
+
+func init() {
+	watchlistAddFlags.RegisterBasic(watchlistAddCmd)
+	watchlistCheckFlags.RegisterBasic(watchlistCheckCmd)
+	for _, cmd := range []*cobra.Command{watchlistAddCmd, watchlistRmCmd} {
+		cmd.Flags().BoolVar(&watchlistByID, "id", false, "Treat the argument as a TMDb ID")
+		cmd.Flags().StringVar(&watchlistType, "type", "all", "Titles to match: "+strings.Join(filters.Media, ", "))
+	}
+	watchlistCmd.AddCommand(watchlistAddCmd, watchlistRmCmd, watchlistLsCmd, watchlistCheckCmd)
+}
+
+func runWatchlistAdd(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+
+	finalRegion, _, _, _, finalTimeout, _ := watchlistAddFlags.Resolve(cmd, cfg)
+
+	mediaType, err := parseListMediaType(watchlistType)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	watchlist, err := store.OpenList(store.Watchlist)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	item, err := resolveTitle(client, args[0], watchlistByID, mediaType, finalRegion)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	if !watchlist.Add(item) {
+		fmt.Printf("%s is already on your watchlist.\n", itemLabel(item))
+		return
+	}
+	if err := watchlist.Save(); err != nil {
+		fmt.Printf("Error saving watchlist: %v\n", err)
+		return
+	}
+	fmt.Printf("Added %s to your watchlist.\n", itemLabel(item))
+}
+
+func runWatchlistRm(cmd *cobra.Command, args []string) {
+	mediaType, err := parseListMediaType(watchlistType)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	watchlist, err := store.OpenList(store.Watchlist)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	i, err := findListItem(watchlist, "watchlist", args[0], watchlistByID, mediaType)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	item := watchlist.Items[i]
+	watchlist.Remove(item.MediaType, item.ID)
+	if err := watchlist.Save(); err != nil {
+		fmt.Printf("Error saving watchlist: %v\n", err)
+		return
+	}
+	fmt.Printf("Removed %s from your watchlist.\n", itemLabel(item))
+}
+
+func runWatchlistLs(cmd *cobra.Command, args []string) {
+	watchlist, err := store.OpenList(store.Watchlist)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if len(watchlist.Items) == 0 {
+		fmt.Println("Your watchlist is empty. Add titles with: tmdb watchlist add <number|id|title>")
+		return
+	}
+
+	for i, item := range watchlist.Items {
+		display.DisplayListItem(listItemDisplay(i+1, item))
+	}
+	display.DisplaySeparator()
+	fmt.Printf("%d titles on your watchlist.\n", len(watchlist.Items))
+}
+
+func runWatchlistCheck(cmd *cobra.Command, args []string) {
+	cfg := config.Get()
+
+	finalRegion, finalProviders, _, _, finalTimeout, _ := watchlistCheckFlags.Resolve(cmd, cfg)
+	desiredProviders := filters.ParseProviders(finalProviders)
+
+	watchlist, err := store.OpenList(store.Watchlist)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if len(watchlist.Items) == 0 {
+		fmt.Println("Your watchlist is empty. Add titles with: tmdb watchlist add <number|id|title>")
+		return
+	}
+
+	client, err := newClient(cfg.APIKey, finalTimeout)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Checking your watchlist for [%s] in region [%s]\n\n", finalProviders, strings.ToUpper(finalRegion))
+
+	available := 0
+	for i, item := range watchlist.Items {
+		var providerData models.RegionProviders
+		if item.MediaType == models.MediaTypeTV {
+			providerData, err = client.GetShowWatchProviders(item.ID, finalRegion)
+		} else {
+			providerData, err = client.GetWatchProviders(item.ID, finalRegion)
+		}
+		if err != nil {
+			fmt.Printf("Error fetching providers for %s: %v\n", itemLabel(item), err)
+			continue
+		}
+
+		itemDisplay := listItemDisplay(i+1, item)
+		itemDisplay.Checked = true
+		itemDisplay.Available, _ = filters.CheckAvailability(providerData, desiredProviders)
+		itemDisplay.Elsewhere = otherProviders(providerData, itemDisplay.Available)
+		if len(itemDisplay.Available) > 0 {
+			available++
+		}
+		display.DisplayListItem(itemDisplay)
+	}
+
+	display.DisplaySeparator()
+	fmt.Printf("%d of %d watchlist titles are streaming on your providers.\n", available, len(watchlist.Items))
+}
+
+// otherProviders returns the flatrate providers of a title that are not among the available ones
+func otherProviders(providerData models.RegionProviders, available []string) []string {
+	var others []string
+	for _, provider := range providerData.Flatrate {
+		if !containsFold(available, provider.ProviderName) && !containsFold(others, provider.ProviderName) {
+			others = append(others, provider.ProviderName)
+		}
+	}
+	return others
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -16,6 +17,11 @@ const (
 	DefaultDebug        = false
 	MaxPagesToSearch    = 5
 	MaxResultsToDisplay = 40
+	// DefaultPriorMean and DefaultPriorVotes are the prior of the Bayesian weighted rating
+	DefaultPriorMean  = 6.5
+	DefaultPriorVotes = 500
+	// DefaultKidsCertification is the maximum age rating in kids mode
+	DefaultKidsCertification = "12"
 )
 
 type Config struct {
@@ -26,11 +32,36 @@ type Config struct {
 	MinVotes  int     `mapstructure:"MIN_VOTES"`
 	Timeout   int     `mapstructure:"API_TIMEOUT_SECONDS"`
 	DEBUG     bool    `mapstructure:"DEBUG"`
+	// Scoring is "raw" or "weighted", PriorMean and PriorVotes configure the weighted rating
+	Scoring    string  `mapstructure:"SCORING"`
+	PriorMean  float64 `mapstructure:"PRIOR_MEAN"`
+	PriorVotes int     `mapstructure:"PRIOR_VOTES"`
+	// MaxCertification is a default --max-certification. KidsMode applies it (or
+	// DefaultKidsCertification) automatically and excludes adult search results.
+	MaxCertification string `mapstructure:"MAX_CERTIFICATION"`
+	KidsMode         bool   `mapstructure:"KIDS_MODE"`
+	// Where is a default --where filter expression
+	Where string `mapstructure:"WHERE"`
 }
 
 var AppConfig Config
 
+// loaded is set once the .env file was read, later calls of Init (e.g. for each
+// command of an interactive shell) only refresh AppConfig
+var loaded bool
+
+// Variables are the settings that can be changed for a session with Set
+var Variables = []string{"REGION", "PROVIDERS", "MIN_RATING", "MIN_VOTES", "API_TIMEOUT_SECONDS", "SCORING", "PRIOR_MEAN", "PRIOR_VOTES", "MAX_CERTIFICATION", "KIDS_MODE", "WHERE", "DEBUG"}
+
 func Init() {
+	if loaded {
+		if err := viper.Unmarshal(&AppConfig); err != nil {
+			fmt.Printf("FEHLER: Konvertierungsfehler in das Config-Struct: %v\n", err)
+		}
+		return
+	}
+	loaded = true
+
 	viper.SetConfigFile(".env")
 	viper.SetConfigType("env")
 
@@ -43,6 +74,12 @@ func Init() {
 	viper.SetDefault("API_TIMEOUT_SECONDS", DefaultTimeout)
 	viper.SetDefault("DEBUG", DefaultDebug)
 	viper.SetDefault("TMDB_API_KEY", "")
+	viper.SetDefault("WHERE", "")
+	viper.SetDefault("MAX_CERTIFICATION", "")
+	viper.SetDefault("KIDS_MODE", false)
+	viper.SetDefault("SCORING", "raw")
+	viper.SetDefault("PRIOR_MEAN", DefaultPriorMean)
+	viper.SetDefault("PRIOR_VOTES", DefaultPriorVotes)
 
 	if err := viper.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
@@ -63,3 +100,58 @@ func Init() {
 func Get() Config {
 	return AppConfig
 }
+
+// VariableNames returns the settings in flag notation, e.g. "min-rating"
+func VariableNames() []string {
+	names := make([]string, len(Variables))
+	for i, variable := range Variables {
+		names[i] = flagName(variable)
+	}
+	return names
+}
+
+func flagName(key string) string {
+	return strings.ToLower(strings.ReplaceAll(key, "_", "-"))
+}
+
+// VariableName normalizes a setting name, e.g. "min-rating" to "MIN_RATING"
+// and "timeout" to "API_TIMEOUT_SECONDS"
+func VariableName(name string) (string, error) {
+	key := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(name), "-", "_"))
+	if key == "TIMEOUT" {
+		key = "API_TIMEOUT_SECONDS"
+	}
+	for _, variable := range Variables {
+		if variable == key {
+			return key, nil
+		}
+	}
+	return "", fmt.Errorf("unknown setting %q (use one of: %s)", name, strings.Join(VariableNames(), ", "))
+}
+
+// Set overrides a setting for the rest of the process, e.g. Set("region", "US").
+// Values that don't fit the setting's type are rejected.
+func Set(name, value string) error {
+	key, err := VariableName(name)
+	if err != nil {
+		return err
+	}
+
+	previous := viper.Get(key)
+	viper.Set(key, value)
+	var updated Config
+	if err := viper.Unmarshal(&updated); err != nil {
+		viper.Set(key, previous)
+		return fmt.Errorf("invalid value %q for %s", value, flagName(key))
+	}
+	AppConfig = updated
+	return nil
+}
+
+// SavedSearch returns the filter expression saved as WHERE_<NAME>, e.g.
+// WHERE_CLASSICS for `--where @classics`
+func SavedSearch(name string) (string, bool) {
+	key := "WHERE_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+	value := viper.GetString(key)
+	return value, value != ""
+}
@@ -46,17 +46,20 @@ func (df *DetailsFetcher) BuildMovieDisplay(number int, movie *models.Movie, pro
 	}
 
 	return MovieDisplay{
-		Number:       number,
-		Title:        regionalTitle,
-		EnglishTitle: englishTitle,
-		Year:         movie.GetYear(),
-		Rating:       movie.VoteAverage,
-		Votes:        movie.VoteCount,
-		Providers:    providers,
-		TmdbID:       movie.ID,
-		ImdbID:       externalIDs.ImdbID,
-		Overview:     movie.Overview,
-		Genres:       genres,
+		Number:           number,
+		Title:            regionalTitle,
+		EnglishTitle:     englishTitle,
+		Year:             movie.GetYear(),
+		Rating:           movie.VoteAverage,
+		Votes:            movie.VoteCount,
+		Providers:        providers,
+		TmdbID:           movie.ID,
+		ImdbID:           externalIDs.ImdbID,
+		Overview:         movie.Overview,
+		Genres:           genres,
+		OriginalLanguage: movie.OriginalLanguage,
+		Certification:    movie.Certification,
+		Runtime:          movie.Runtime,
 	}
 }
 
@@ -71,16 +74,19 @@ func (df *DetailsFetcher) BuildMovieDisplaySimple(number int, movie *models.Movi
 	}
 
 	return MovieDisplay{
-		Number:       number,
-		Title:        movie.GetTitle(),
-		EnglishTitle: englishTitle,
-		Year:         movie.GetYear(),
-		Rating:       movie.VoteAverage,
-		Votes:        movie.VoteCount,
-		Providers:    providers,
-		TmdbID:       movie.ID,
-		ImdbID:       externalIDs.ImdbID,
-		Overview:     movie.Overview,
-		Genres:       genres,
+		Number:           number,
+		Title:            movie.GetTitle(),
+		EnglishTitle:     englishTitle,
+		Year:             movie.GetYear(),
+		Rating:           movie.VoteAverage,
+		Votes:            movie.VoteCount,
+		Providers:        providers,
+		TmdbID:           movie.ID,
+		ImdbID:           externalIDs.ImdbID,
+		Overview:         movie.Overview,
+		Genres:           genres,
+		OriginalLanguage: movie.OriginalLanguage,
+		Certification:    movie.Certification,
+		Runtime:          movie.Runtime,
 	}
 }
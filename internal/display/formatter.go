@@ -3,6 +3,9 @@ package display
 import (
 	"fmt"
 	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
 )
 
 type MovieDisplay struct {
@@ -17,10 +20,28 @@ type MovieDisplay struct {
 	ImdbID       string
 	Overview     string
 	Character    string
-	Genres       []string
+	// Job lists crew jobs, e.g. "Director, Writer"
+	Job string
+	// Roles lists the credits of several people, e.g. "Tom Hanks (Sam Baldwin)"
+	Roles  []string
+	Genres []string
+	// OriginalLanguage is an ISO 639-1 code
+	OriginalLanguage string
+	// Runtime in minutes, 0 if unknown
+	Runtime int
+	// WeightedRating is the Bayesian weighted rating, 0 if not computed
+	WeightedRating float64
+	// Certification is the age rating in the watch region, if known
+	Certification string
+	// GemScore is the hidden-gem score, 0 if not computed
+	GemScore float64
+	// ReleaseRegion and Releases describe region-specific release dates (e.g. for upcoming movies)
+	ReleaseRegion string
+	Releases      []models.ReleaseDate
 }
 
 func DisplayMovie(m MovieDisplay) {
+	recordResult(m.Number, models.MediaTypeMovie, m.TmdbID, m.Title, m.Year)
 	fmt.Println(SeparatorStyle.Render(strings.Repeat("=", 60)))
 
 	englishTitleDisplay := ""
@@ -29,7 +50,23 @@ func DisplayMovie(m MovieDisplay) {
 	}
 
 	fmt.Printf("%d. %s%s %s\n", m.Number, TitleStyle.Render(m.Title), englishTitleDisplay, m.Year)
-	fmt.Printf("   Rating: %s/10 (Votes: %d)\n", RatingStyle.Render(fmt.Sprintf("%.1f", m.Rating)), m.Votes)
+	fmt.Printf("   Rating: %s/10 (Votes: %d)%s\n", RatingStyle.Render(fmt.Sprintf("%.1f", m.Rating)), m.Votes, formatWeightedRating(m.WeightedRating))
+
+	if m.GemScore > 0 {
+		fmt.Printf("   Hidden gem score: %s\n", HighlightStyle.Render(fmt.Sprintf("%.2f", m.GemScore)))
+	}
+
+	if m.OriginalLanguage != "" {
+		fmt.Printf("   Language: %s\n", models.FormatLanguage(m.OriginalLanguage))
+	}
+
+	if m.Certification != "" {
+		fmt.Printf("   Age rating: %s\n", m.Certification)
+	}
+
+	if m.Runtime > 0 {
+		fmt.Printf("   Runtime: %s\n", models.FormatRuntime(m.Runtime))
+	}
 
 	if len(m.Genres) > 0 {
 		genreStr := strings.Join(m.Genres, ", ")
@@ -40,11 +77,19 @@ func DisplayMovie(m MovieDisplay) {
 		fmt.Printf("   Character: %s\n", m.Character)
 	}
 
-	styledProviders := make([]string, len(m.Providers))
-	for i, p := range m.Providers {
-		styledProviders[i] = ProviderStyle.Render(p)
+	if m.Job != "" {
+		fmt.Printf("   Job: %s\n", m.Job)
 	}
-	fmt.Printf("   STREAMING on: %s\n", strings.Join(styledProviders, ", "))
+
+	if len(m.Roles) > 0 {
+		fmt.Printf("   Roles: %s\n", strings.Join(m.Roles, ", "))
+	}
+
+	if len(m.Releases) > 0 {
+		fmt.Printf("   Release (%s): %s\n", strings.ToUpper(m.ReleaseRegion), FormatReleaseDates(m.Releases))
+	}
+
+	fmt.Printf("   STREAMING on: %s\n", formatProviders(m.Providers))
 
 	fmt.Printf("   TMDb Details: https://www.themoviedb.org/movie/%d\n", m.TmdbID)
 	if m.ImdbID != "" {
@@ -58,6 +103,34 @@ func DisplaySeparator() {
 	fmt.Println(SeparatorStyle.Render(strings.Repeat("=", 60)))
 }
 
+// FormatReleaseDates renders release dates as "2025-10-22 Theatrical, 2026-01-15 Digital"
+func FormatReleaseDates(dates []models.ReleaseDate) string {
+	parts := make([]string, len(dates))
+	for i, d := range dates {
+		parts[i] = RatingStyle.Render(d.GetDate()) + " " + d.GetTypeName()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatWeightedRating renders the weighted rating as a suffix of the rating line
+func formatWeightedRating(weighted float64) string {
+	if weighted <= 0 {
+		return ""
+	}
+	return " | Weighted: " + RatingStyle.Render(fmt.Sprintf("%.2f", weighted))
+}
+
+func formatProviders(providers []string) string {
+	if len(providers) == 0 {
+		return OriginalTitleStyle.Render("not on your providers")
+	}
+	styledProviders := make([]string, len(providers))
+	for i, p := range providers {
+		styledProviders[i] = ProviderStyle.Render(p)
+	}
+	return strings.Join(styledProviders, ", ")
+}
+
 func truncateString(s string, maxLen int) string {
 	if len(s) > maxLen {
 		return strings.TrimSpace(s[:maxLen]) + "..."
@@ -77,6 +150,27 @@ type ShowDisplay struct {
 	ImdbID       string
 	TvdbID       int
 	Overview     string
+	Genres       []string
+	// OriginalLanguage is an ISO 639-1 code
+	OriginalLanguage string
+	// WeightedRating is the Bayesian weighted rating, 0 if not computed
+	WeightedRating float64
+	// Certification is the content rating in the watch region, if known
+	Certification string
+	// Character, Job and EpisodeCount describe a person's credit in filmographies
+	Character    string
+	Job          string
+	EpisodeCount int
+	// Roles lists the credits of several people, e.g. "Tom Hanks (Sam Baldwin)"
+	Roles []string
+	// NextEpisode is the upcoming episode, if known (e.g. for airing shows)
+	NextEpisode *models.Episode
+	// SeasonPremiere highlights shows starting a new season this week
+	SeasonPremiere bool
+	// EpisodeRuntime in minutes, 0 if unknown
+	EpisodeRuntime int
+	// FitsEpisodes is the number of episodes fitting the --fits time budget
+	FitsEpisodes int
 }
 
 type ActorDisplay struct {
@@ -88,13 +182,130 @@ type ActorDisplay struct {
 }
 
 func DisplayActor(a ActorDisplay) {
+	recordResult(a.Number, models.MediaTypePerson, a.TmdbID, a.Name, "")
 	fmt.Println(SeparatorStyle.Render(strings.Repeat("-", 60)))
 	fmt.Printf("%d. %s\n", a.Number, ActorNameStyle.Render(a.Name))
 	fmt.Printf("   Popularity: %s\n", PopularityStyle.Render(fmt.Sprintf("%.1f", a.Popularity)))
+	if a.ProfilePath != "" {
+		fmt.Printf("   Photo: %s\n", models.ProfileImageURL(a.ProfilePath, "w185"))
+	}
 	fmt.Printf("   TMDb Profile: https://www.themoviedb.org/person/%d\n", a.TmdbID)
 }
 
+// PersonDisplay is the header of a filmography: a person's details and career summary
+type PersonDisplay struct {
+	Person models.PersonDetails
+	// Age is the current age or the age at death, 0 if unknown
+	Age    int
+	Career filters.CareerStats
+	// Shows is the number of TV credits
+	Shows int
+}
+
+func DisplayPerson(p PersonDisplay) {
+	fmt.Println(SeparatorStyle.Render(strings.Repeat("=", 60)))
+	fmt.Printf("%s\n", ActorNameStyle.Render(p.Person.Name))
+
+	if p.Person.KnownForDepartment != "" {
+		fmt.Printf("   Known for: %s\n", p.Person.KnownForDepartment)
+	}
+
+	if p.Person.Birthday != "" {
+		born := p.Person.Birthday
+		if p.Person.PlaceOfBirth != "" {
+			born += " in " + p.Person.PlaceOfBirth
+		}
+		if p.Person.Deathday == "" && p.Age > 0 {
+			born += fmt.Sprintf(" (age %d)", p.Age)
+		}
+		fmt.Printf("   Born: %s\n", born)
+	}
+
+	if p.Person.Deathday != "" {
+		died := p.Person.Deathday
+		if p.Age > 0 {
+			died += fmt.Sprintf(" (aged %d)", p.Age)
+		}
+		fmt.Printf("   Died: %s\n", died)
+	}
+
+	career := fmt.Sprintf("%d films", p.Career.Films)
+	if p.Shows > 0 {
+		career += fmt.Sprintf(", %d TV shows", p.Shows)
+	}
+	if p.Career.FirstYear > 0 {
+		career += fmt.Sprintf(", active %d-%d", p.Career.FirstYear, p.Career.LastYear)
+	}
+	fmt.Printf("   Career: %s\n", career)
+
+	if p.Career.RatedFilms > 0 {
+		fmt.Printf("   Average rating: %s/10 (%d rated films)\n", RatingStyle.Render(fmt.Sprintf("%.1f", p.Career.AverageRating)), p.Career.RatedFilms)
+	}
+	if p.Career.Best != nil {
+		fmt.Printf("   Best rated: %s %s %s/10\n", p.Career.Best.GetTitle(), p.Career.Best.GetYear(), RatingStyle.Render(fmt.Sprintf("%.1f", p.Career.Best.VoteAverage)))
+	}
+
+	if p.Person.ProfilePath != "" {
+		fmt.Printf("   Photo: %s\n", models.ProfileImageURL(p.Person.ProfilePath, "w185"))
+	}
+	fmt.Printf("   TMDb Profile: https://www.themoviedb.org/person/%d\n", p.Person.ID)
+
+	if p.Person.Biography != "" {
+		fmt.Printf("   Biography: %s\n", truncateString(p.Person.Biography, 300))
+	}
+	fmt.Println()
+}
+
+type SearchResultDisplay struct {
+	Number    int
+	MediaType string
+	Title     string
+	Year      string
+	Rating    float64
+	Votes     int
+	// KnownFor is the department and notable titles of a person
+	KnownFor string
+	// OriginalLanguage is an ISO 639-1 code of a movie or show
+	OriginalLanguage string
+	TmdbID           int
+}
+
+// MediaTypeBadge renders a colored badge for a /search/multi media type
+func MediaTypeBadge(mediaType string) string {
+	switch mediaType {
+	case models.MediaTypeMovie:
+		return MovieBadgeStyle.Render("MOVIE ")
+	case models.MediaTypeTV:
+		return ShowBadgeStyle.Render("TV    ")
+	case models.MediaTypePerson:
+		return PersonBadgeStyle.Render("PERSON")
+	}
+	return strings.ToUpper(mediaType)
+}
+
+func DisplaySearchResult(r SearchResultDisplay) {
+	recordResult(r.Number, r.MediaType, r.TmdbID, r.Title, r.Year)
+	fmt.Println(SeparatorStyle.Render(strings.Repeat("-", 60)))
+
+	if r.MediaType == models.MediaTypePerson {
+		fmt.Printf("%d. %s %s\n", r.Number, MediaTypeBadge(r.MediaType), ActorNameStyle.Render(r.Title))
+		if r.KnownFor != "" {
+			fmt.Printf("   Known for: %s\n", OriginalTitleStyle.Render(r.KnownFor))
+		}
+		fmt.Printf("   TMDb Profile: https://www.themoviedb.org/person/%d\n", r.TmdbID)
+		return
+	}
+
+	fmt.Printf("%d. %s %s %s\n", r.Number, MediaTypeBadge(r.MediaType), TitleStyle.Render(r.Title), r.Year)
+	fmt.Printf("   Rating: %s/10 (Votes: %d)\n", RatingStyle.Render(fmt.Sprintf("%.1f", r.Rating)), r.Votes)
+	if r.OriginalLanguage != "" {
+		fmt.Printf("   Language: %s\n", models.FormatLanguage(r.OriginalLanguage))
+	}
+	fmt.Printf("   TMDb Details: https://www.themoviedb.org/%s/%d\n", r.MediaType, r.TmdbID)
+}
+
 func DisplayShow(s ShowDisplay) {
+	recordResult(s.Number, models.MediaTypeTV, s.TmdbID, s.Title, s.Year)
 	fmt.Println(SeparatorStyle.Render(strings.Repeat("=", 60)))
 
 	englishTitleDisplay := ""
@@ -102,14 +313,55 @@ func DisplayShow(s ShowDisplay) {
 		englishTitleDisplay = OriginalTitleStyle.Render(" (" + s.EnglishTitle + ")")
 	}
 
-	fmt.Printf("%d. %s%s %s\n", s.Number, TitleStyle.Render(s.Title), englishTitleDisplay, s.Year)
-	fmt.Printf("   Rating: %s/10 (Votes: %d)\n", RatingStyle.Render(fmt.Sprintf("%.1f", s.Rating)), s.Votes)
+	premiereDisplay := ""
+	if s.SeasonPremiere {
+		premiereDisplay = " " + HighlightStyle.Render("SEASON PREMIERE")
+	}
+
+	fmt.Printf("%d. %s%s %s%s\n", s.Number, TitleStyle.Render(s.Title), englishTitleDisplay, s.Year, premiereDisplay)
+	fmt.Printf("   Rating: %s/10 (Votes: %d)%s\n", RatingStyle.Render(fmt.Sprintf("%.1f", s.Rating)), s.Votes, formatWeightedRating(s.WeightedRating))
 
-	styledProviders := make([]string, len(s.Providers))
-	for i, p := range s.Providers {
-		styledProviders[i] = ProviderStyle.Render(p)
+	if len(s.Genres) > 0 {
+		fmt.Printf("   Genres: %s\n", OriginalTitleStyle.Render(strings.Join(s.Genres, ", ")))
+	}
+
+	if s.OriginalLanguage != "" {
+		fmt.Printf("   Language: %s\n", models.FormatLanguage(s.OriginalLanguage))
+	}
+
+	if s.Certification != "" {
+		fmt.Printf("   Age rating: %s\n", s.Certification)
 	}
-	fmt.Printf("   STREAMING on: %s\n", strings.Join(styledProviders, ", "))
+
+	if s.EpisodeRuntime > 0 {
+		fmt.Printf("   Episode Runtime: %s\n", models.FormatRuntime(s.EpisodeRuntime))
+	}
+
+	if s.Character != "" {
+		fmt.Printf("   Character: %s\n", s.Character)
+	}
+
+	if s.Job != "" {
+		fmt.Printf("   Job: %s\n", s.Job)
+	}
+
+	if s.EpisodeCount > 0 {
+		fmt.Printf("   Episodes: %d\n", s.EpisodeCount)
+	}
+
+	if len(s.Roles) > 0 {
+		fmt.Printf("   Roles: %s\n", strings.Join(s.Roles, ", "))
+	}
+
+	if s.FitsEpisodes > 0 {
+		fmt.Printf("   Fits: %s episodes\n", RatingStyle.Render(fmt.Sprintf("%d", s.FitsEpisodes)))
+	}
+
+	if s.NextEpisode != nil {
+		fmt.Printf("   Next Episode: %s on %s\n", s.NextEpisode.GetCode(), RatingStyle.Render(s.NextEpisode.AirDate))
+	}
+
+	fmt.Printf("   STREAMING on: %s\n", formatProviders(s.Providers))
 
 	fmt.Printf("   TMDb Details: https://www.themoviedb.org/tv/%d\n", s.TmdbID)
 	if s.ImdbID != "" {
@@ -0,0 +1,56 @@
+package display
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ListItemDisplay is an entry of a local list, e.g. the watchlist or the seen history
+type ListItemDisplay struct {
+	Number    int
+	MediaType string
+	Title     string
+	Year      string
+	TmdbID    int
+	AddedAt   time.Time
+	// DateLabel describes AddedAt, e.g. "Seen"; "Added" if empty
+	DateLabel string
+	// Rating is the user's own rating, 0 if not rated
+	Rating float64
+	// Checked is set once the providers were fetched: Available are the desired
+	// providers streaming the title, Elsewhere the other flatrate providers in the region
+	Checked   bool
+	Available []string
+	Elsewhere []string
+}
+
+func DisplayListItem(w ListItemDisplay) {
+	recordResult(w.Number, w.MediaType, w.TmdbID, w.Title, w.Year)
+
+	fmt.Println(SeparatorStyle.Render(strings.Repeat("-", 60)))
+
+	year := ""
+	if w.Year != "" {
+		year = "(" + w.Year + ")"
+	}
+	fmt.Printf("%d. %s %s %s\n", w.Number, MediaTypeBadge(w.MediaType), TitleStyle.Render(w.Title), year)
+	if !w.AddedAt.IsZero() {
+		label := w.DateLabel
+		if label == "" {
+			label = "Added"
+		}
+		fmt.Printf("   %s: %s\n", label, w.AddedAt.Format("2006-01-02"))
+	}
+	if w.Rating > 0 {
+		fmt.Printf("   Your rating: %s/10\n", RatingStyle.Render(fmt.Sprintf("%.1f", w.Rating)))
+	}
+
+	if w.Checked {
+		fmt.Printf("   STREAMING on: %s\n", formatProviders(w.Available))
+		if len(w.Elsewhere) > 0 {
+			fmt.Printf("   Also on: %s\n", OriginalTitleStyle.Render(strings.Join(w.Elsewhere, ", ")))
+		}
+	}
+	fmt.Printf("   TMDb Details: https://www.themoviedb.org/%s/%d\n", w.MediaType, w.TmdbID)
+}
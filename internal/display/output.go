@@ -12,6 +12,14 @@ func PrintSearchStartMessage(searchType string, minRating float64, minVotes int,
 	fmt.Printf("Filtering for [%s] in region [%s]\n\n", providers, strings.ToUpper(region))
 }
 
+// PrintActiveFilters prints the optional filters in effect, if any
+func PrintActiveFilters(descriptions []string) {
+	if len(descriptions) == 0 {
+		return
+	}
+	fmt.Printf("Filters: %s\n\n", strings.Join(descriptions, " | "))
+}
+
 // PrintSearchResultsSummary prints the final results summary
 func PrintSearchResultsSummary(searchType string, resultsFound int) {
 	DisplaySeparator()
@@ -0,0 +1,31 @@
+package display
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/graph"
+)
+
+// PathDisplay is a co-star chain between two people
+type PathDisplay struct {
+	Path graph.Path
+	// Providers holds the desired providers of the movies in the path, by movie ID
+	Providers map[int][]string
+}
+
+func DisplayPath(p PathDisplay) {
+	fmt.Println(SeparatorStyle.Render(strings.Repeat("=", 60)))
+	for i, person := range p.Path.People {
+		fmt.Printf("%s\n", ActorNameStyle.Render(person.Name))
+		if i >= len(p.Path.Movies) {
+			break
+		}
+		movie := p.Path.Movies[i]
+		fmt.Printf("  └─ %s %s %s/10\n", movie.GetTitle(), movie.GetYear(), RatingStyle.Render(fmt.Sprintf("%.1f", movie.VoteAverage)))
+		if providers, ok := p.Providers[movie.ID]; ok {
+			fmt.Printf("     STREAMING on: %s\n", formatProviders(providers))
+		}
+	}
+	fmt.Println(SeparatorStyle.Render(strings.Repeat("=", 60)))
+}
@@ -0,0 +1,41 @@
+package display
+
+import "strings"
+
+// Result is a numbered entry of the last displayed list, so it can be referred to
+// by its number afterwards, e.g. `open 3` in the shell
+type Result struct {
+	Number    int    `json:"number"`
+	MediaType string `json:"media_type"`
+	ID        int    `json:"id"`
+	Title     string `json:"title"`
+	Year      string `json:"year,omitempty"`
+}
+
+var results []Result
+
+// ResetResults forgets the results of the previous list
+func ResetResults() {
+	results = nil
+}
+
+// LastResults returns the results displayed since the last ResetResults
+func LastResults() []Result {
+	return results
+}
+
+// ResultsByNumber returns the results displayed with the number. Lists of movies and
+// shows (e.g. a filmography) number both from 1, so a number can match several results.
+func ResultsByNumber(r []Result, number int, mediaType string) []Result {
+	var matches []Result
+	for _, result := range r {
+		if result.Number == number && (mediaType == "" || result.MediaType == mediaType) {
+			matches = append(matches, result)
+		}
+	}
+	return matches
+}
+
+func recordResult(number int, mediaType string, id int, title, year string) {
+	results = append(results, Result{Number: number, MediaType: mediaType, ID: id, Title: title, Year: strings.Trim(year, "()")})
+}
@@ -33,4 +33,32 @@ var (
 	PopularityStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#00FF00")).
 			Inline(true)
+
+	HighlightStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#1A1A1A")).
+			Background(lipgloss.Color("#FF5F87")).
+			Padding(0, 1).
+			Inline(true)
+
+	MovieBadgeStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#1A1A1A")).
+			Background(lipgloss.Color("#FCE043")).
+			Padding(0, 1).
+			Inline(true)
+
+	ShowBadgeStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#1A1A1A")).
+			Background(lipgloss.Color("#00BFFF")).
+			Padding(0, 1).
+			Inline(true)
+
+	PersonBadgeStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(lipgloss.Color("#1A1A1A")).
+				Background(lipgloss.Color("#00FF00")).
+				Padding(0, 1).
+				Inline(true)
 )
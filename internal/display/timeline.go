@@ -0,0 +1,120 @@
+package display
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// Timeline chart markers
+const (
+	timelineMarkTop       = "★"
+	timelineMarkAvailable = "●"
+	timelineMarkOther     = "○"
+)
+
+// TimelineDisplay is a career timeline: average rating per year, with the films
+// available on the desired providers and the top rated films marked
+type TimelineDisplay struct {
+	Name  string
+	Years []filters.TimelineYear
+	// Available holds the IDs of films available on the desired providers
+	Available map[int]bool
+	// Top are the best rated films, highlighted in the chart
+	Top []models.Movie
+}
+
+func DisplayTimeline(t TimelineDisplay) {
+	fmt.Println(SeparatorStyle.Render(strings.Repeat("=", 60)))
+	fmt.Printf("Career timeline of %s (average rating per year)\n\n", ActorNameStyle.Render(t.Name))
+	fmt.Print(RenderTimeline(t))
+}
+
+// RenderTimeline renders the chart with one column per year from the first to the last
+// film and one row per half rating point. A year is marked as top film year (★),
+// available (●) or not available (○), in this order.
+func RenderTimeline(t TimelineDisplay) string {
+	if len(t.Years) == 0 {
+		return "No rated films to chart.\n"
+	}
+
+	top := make(map[int]bool)
+	for _, film := range t.Top {
+		top[film.ID] = true
+	}
+
+	first, last := t.Years[0].Year, t.Years[len(t.Years)-1].Year
+	low, high := 10.0, 0.0
+	for _, year := range t.Years {
+		low = math.Min(low, year.AverageRating)
+		high = math.Max(high, year.AverageRating)
+	}
+	low = math.Floor(low*2) / 2
+	high = math.Ceil(high*2) / 2
+	if high == low {
+		high += 0.5
+	}
+	rows := int((high-low)*2) + 1
+
+	grid := make([][]string, rows)
+	for row := range grid {
+		grid[row] = make([]string, last-first+1)
+		for col := range grid[row] {
+			grid[row][col] = " "
+		}
+	}
+	for _, year := range t.Years {
+		row := int(math.Round((high - year.AverageRating) * 2))
+		grid[row][year.Year-first] = timelineMark(year, top, t.Available)
+	}
+
+	var b strings.Builder
+	for row, cells := range grid {
+		fmt.Fprintf(&b, "%5.1f ┤%s\n", high-float64(row)/2, strings.Join(cells, ""))
+	}
+	fmt.Fprintf(&b, "      └%s\n", strings.Repeat("─", last-first+1))
+	fmt.Fprintf(&b, "       %s\n\n", timelineYearLabels(first, last))
+
+	fmt.Fprintf(&b, "%s top film  %s on your providers  %s not on your providers\n",
+		RatingStyle.Render(timelineMarkTop), ProviderStyle.Render(timelineMarkAvailable), timelineMarkOther)
+	for i, film := range t.Top {
+		available := ""
+		if t.Available[film.ID] {
+			available = " " + ProviderStyle.Render("(on your providers)")
+		}
+		fmt.Fprintf(&b, "  %d. %s %s %s/10%s\n", i+1, film.GetTitle(), film.GetYear(),
+			RatingStyle.Render(fmt.Sprintf("%.1f", film.VoteAverage)), available)
+	}
+	return b.String()
+}
+
+func timelineMark(year filters.TimelineYear, top, available map[int]bool) string {
+	mark := timelineMarkOther
+	for _, film := range year.Films {
+		if top[film.ID] {
+			return RatingStyle.Render(timelineMarkTop)
+		}
+		if available[film.ID] {
+			mark = ProviderStyle.Render(timelineMarkAvailable)
+		}
+	}
+	return mark
+}
+
+// timelineYearLabels labels every decade (or the first year of short careers) below its column
+func timelineYearLabels(first, last int) string {
+	labels := []byte(strings.Repeat(" ", last-first+4))
+	next := 0
+	for year := first; year <= last; year++ {
+		col := year - first
+		if (year%10 != 0 && year != first) || col < next {
+			continue
+		}
+		copy(labels[col:], fmt.Sprintf("%d", year))
+		next = col + 5
+	}
+	return strings.TrimRight(string(labels), " ")
+}
@@ -0,0 +1,156 @@
+package expr
+
+import "strings"
+
+type node interface {
+	eval(record Record) Value
+	kind() Kind
+	pos() int
+}
+
+type literalNode struct {
+	value  Value
+	column int
+}
+
+func (n *literalNode) eval(Record) Value { return n.value }
+func (n *literalNode) kind() Kind        { return n.value.Kind }
+func (n *literalNode) pos() int          { return n.column }
+
+type fieldNode struct {
+	name   string
+	column int
+}
+
+// eval returns the zero value of the field type for fields missing from the record
+func (n *fieldNode) eval(record Record) Value {
+	if value, ok := record[n.name]; ok {
+		return value
+	}
+	return Value{Kind: Fields[n.name]}
+}
+func (n *fieldNode) kind() Kind { return Fields[n.name] }
+func (n *fieldNode) pos() int   { return n.column }
+
+type listNode struct {
+	items  []node
+	column int
+}
+
+func (n *listNode) eval(record Record) Value {
+	list := make([]Value, len(n.items))
+	for i, item := range n.items {
+		list[i] = item.eval(record)
+	}
+	return Value{Kind: KindList, List: list}
+}
+func (n *listNode) kind() Kind { return KindList }
+func (n *listNode) pos() int   { return n.column }
+
+type rangeNode struct {
+	lower  node
+	upper  node
+	column int
+}
+
+func (n *rangeNode) eval(record Record) Value {
+	return Value{Kind: kindRange, List: []Value{n.lower.eval(record), n.upper.eval(record)}}
+}
+func (n *rangeNode) kind() Kind { return kindRange }
+func (n *rangeNode) pos() int   { return n.column }
+
+type notNode struct {
+	operand node
+	column  int
+}
+
+func (n *notNode) eval(record Record) Value { return Bool(!n.operand.eval(record).Bool) }
+func (n *notNode) kind() Kind               { return KindBool }
+func (n *notNode) pos() int                 { return n.column }
+
+type binaryNode struct {
+	op     string
+	left   node
+	right  node
+	column int
+}
+
+func (n *binaryNode) kind() Kind { return KindBool }
+func (n *binaryNode) pos() int   { return n.left.pos() }
+
+func (n *binaryNode) eval(record Record) Value {
+	switch n.op {
+	case "&&":
+		return Bool(n.left.eval(record).Bool && n.right.eval(record).Bool)
+	case "||":
+		return Bool(n.left.eval(record).Bool || n.right.eval(record).Bool)
+	}
+
+	left := n.left.eval(record)
+	right := n.right.eval(record)
+
+	switch n.op {
+	case "==":
+		return Bool(looselyEqual(left, right))
+	case "!=":
+		return Bool(!looselyEqual(left, right))
+	case "<", "<=", ">", ">=":
+		return Bool(compare(n.op, left, right))
+	case "in":
+		return Bool(contains(right, left))
+	case "contains":
+		if left.Kind == KindString {
+			return Bool(strings.Contains(strings.ToLower(left.Str), strings.ToLower(right.Str)))
+		}
+		return Bool(contains(left, right))
+	}
+	return Bool(false)
+}
+
+// looselyEqual compares values; a list equals a string if any element does
+func looselyEqual(left, right Value) bool {
+	if left.Kind == KindList {
+		return contains(left, right)
+	}
+	if right.Kind == KindList {
+		return contains(right, left)
+	}
+	return left.equals(right)
+}
+
+func compare(op string, left, right Value) bool {
+	var cmp int
+	if left.Kind == KindNumber {
+		switch {
+		case left.Number < right.Number:
+			cmp = -1
+		case left.Number > right.Number:
+			cmp = 1
+		}
+	} else {
+		cmp = strings.Compare(strings.ToLower(left.Str), strings.ToLower(right.Str))
+	}
+
+	switch op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	}
+	return cmp >= 0
+}
+
+// contains checks list membership or whether a number lies within a range (inclusive)
+func contains(collection, item Value) bool {
+	if collection.Kind == kindRange {
+		return item.Number >= collection.List[0].Number && item.Number <= collection.List[1].Number
+	}
+	for _, element := range collection.List {
+		if element.equals(item) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,341 @@
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseError is a syntax or type error at a 1-based column of the expression
+type ParseError struct {
+	Column  int
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Column, e.Message)
+}
+
+// FormatError renders an error with the expression and a caret under the
+// offending column. Errors other than ParseError are returned as is.
+func FormatError(input string, err error) string {
+	parseErr, ok := err.(*ParseError)
+	if !ok {
+		return err.Error()
+	}
+	return fmt.Sprintf("%s\n  %s\n  %s^", parseErr.Error(), input, strings.Repeat(" ", parseErr.Column-1))
+}
+
+// Expression is a parsed and type-checked filter expression
+type Expression struct {
+	source string
+	root   node
+	fields map[string]bool
+}
+
+// Parse parses a filter expression such as
+//
+//	rating >= 7 && votes > 500 && year in 1990..1999 && "Comedy" in genres && provider == "Netflix"
+func Parse(input string) (*Expression, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, fields: map[string]bool{}}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorf(tok, "unexpected %s", tok)
+	}
+	if root.kind() != KindBool {
+		return nil, &ParseError{Column: root.pos(), Message: fmt.Sprintf("expression must be a condition, not a %s", root.kind())}
+	}
+
+	return &Expression{source: input, root: root, fields: p.fields}, nil
+}
+
+// Eval reports whether the record matches the expression
+func (e *Expression) Eval(record Record) bool {
+	return e.root.eval(record).Bool
+}
+
+// Uses reports whether the expression references the given field, e.g. to
+// decide whether runtimes have to be fetched
+func (e *Expression) Uses(field string) bool {
+	return e.fields[field]
+}
+
+func (e *Expression) String() string {
+	return e.source
+}
+
+type parser struct {
+	tokens []token
+	index  int
+	fields map[string]bool
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.index]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.index]
+	if tok.kind != tokenEOF {
+		p.index++
+	}
+	return tok
+}
+
+// isOp reports whether the current token is one of the given operators or keywords
+func (p *parser) isOp(ops ...string) bool {
+	tok := p.peek()
+	if tok.kind != tokenOperator && tok.kind != tokenIdent {
+		return false
+	}
+	for _, op := range ops {
+		if strings.EqualFold(tok.text, op) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) errorf(tok token, format string, args ...interface{}) error {
+	return &ParseError{Column: tok.pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOp("||", "or") {
+		opTok := p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if err := expectKind(left, KindBool, opTok); err != nil {
+			return nil, err
+		}
+		if err := expectKind(right, KindBool, opTok); err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: "||", left: left, right: right, column: opTok.pos}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOp("&&", "and") {
+		opTok := p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if err := expectKind(left, KindBool, opTok); err != nil {
+			return nil, err
+		}
+		if err := expectKind(right, KindBool, opTok); err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: "&&", left: left, right: right, column: opTok.pos}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.isOp("!", "not") {
+		opTok := p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if err := expectKind(operand, KindBool, opTok); err != nil {
+			return nil, err
+		}
+		return &notNode{operand: operand, column: opTok.pos}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.isOp("==", "!=", "<", "<=", ">", ">="):
+		opTok := p.next()
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkComparison(opTok, left, right); err != nil {
+			return nil, err
+		}
+		return &binaryNode{op: opTok.text, left: left, right: right, column: opTok.pos}, nil
+
+	case p.isOp("in"):
+		opTok := p.next()
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		if p.isOp("..") {
+			rangeTok := p.next()
+			upper, err := p.parsePrimary()
+			if err != nil {
+				return nil, err
+			}
+			if err := expectKind(right, KindNumber, rangeTok); err != nil {
+				return nil, err
+			}
+			if err := expectKind(upper, KindNumber, rangeTok); err != nil {
+				return nil, err
+			}
+			right = &rangeNode{lower: right, upper: upper, column: right.pos()}
+		}
+		if err := checkIn(opTok, left, right); err != nil {
+			return nil, err
+		}
+		return &binaryNode{op: "in", left: left, right: right, column: opTok.pos}, nil
+
+	case p.isOp("contains"):
+		opTok := p.next()
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		if left.kind() != KindString && left.kind() != KindList {
+			return nil, p.errorf(opTok, "'contains' needs a string or list on the left, got a %s", left.kind())
+		}
+		if err := expectKind(right, KindString, opTok); err != nil {
+			return nil, err
+		}
+		return &binaryNode{op: "contains", left: left, right: right, column: opTok.pos}, nil
+	}
+
+	return left, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenNumber:
+		n, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorf(tok, "invalid number %q", tok.text)
+		}
+		return &literalNode{value: Number(n), column: tok.pos}, nil
+
+	case tokenString:
+		return &literalNode{value: String(tok.text), column: tok.pos}, nil
+
+	case tokenIdent:
+		name := strings.ToLower(tok.text)
+		switch name {
+		case "true", "false":
+			return &literalNode{value: Bool(name == "true"), column: tok.pos}, nil
+		}
+		if alias, ok := fieldAliases[name]; ok {
+			name = alias
+		}
+		if _, ok := Fields[name]; !ok {
+			return nil, p.errorf(tok, "unknown field %q (available: %s)", tok.text, fieldNames())
+		}
+		p.fields[name] = true
+		return &fieldNode{name: name, column: tok.pos}, nil
+
+	case tokenOperator:
+		switch tok.text {
+		case "(":
+			inner, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if closing := p.next(); closing.text != ")" || closing.kind != tokenOperator {
+				return nil, p.errorf(closing, "expected ')' to close '(' at column %d, got %s", tok.pos, closing)
+			}
+			return inner, nil
+
+		case "[":
+			list := &listNode{column: tok.pos}
+			for !p.isOp("]") {
+				if len(list.items) > 0 {
+					if comma := p.next(); comma.text != "," || comma.kind != tokenOperator {
+						return nil, p.errorf(comma, "expected ',' or ']' in list, got %s", comma)
+					}
+				}
+				item, err := p.parsePrimary()
+				if err != nil {
+					return nil, err
+				}
+				if item.kind() != KindNumber && item.kind() != KindString {
+					return nil, &ParseError{Column: item.pos(), Message: "lists may only contain numbers and strings"}
+				}
+				list.items = append(list.items, item)
+			}
+			p.next()
+			return list, nil
+		}
+	}
+
+	return nil, p.errorf(tok, "expected a value, field or '(', got %s", tok)
+}
+
+func expectKind(n node, kind Kind, opTok token) error {
+	if n.kind() != kind {
+		return &ParseError{Column: n.pos(), Message: fmt.Sprintf("'%s' needs a %s, got a %s", opTok.text, kind, n.kind())}
+	}
+	return nil
+}
+
+func checkComparison(opTok token, left, right node) error {
+	lk, rk := left.kind(), right.kind()
+
+	if opTok.text == "==" || opTok.text == "!=" {
+		// A list compared to a string matches if any element equals it
+		if (lk == KindList && rk == KindString) || (lk == KindString && rk == KindList) {
+			return nil
+		}
+		if lk == rk && lk != KindList {
+			return nil
+		}
+		return &ParseError{Column: opTok.pos, Message: fmt.Sprintf("cannot compare a %s with a %s", lk, rk)}
+	}
+
+	if lk == rk && (lk == KindNumber || lk == KindString) {
+		return nil
+	}
+	return &ParseError{Column: opTok.pos, Message: fmt.Sprintf("'%s' needs two numbers or two strings, got a %s and a %s", opTok.text, lk, rk)}
+}
+
+func checkIn(opTok token, left, right node) error {
+	switch right.kind() {
+	case kindRange:
+		if left.kind() != KindNumber {
+			return &ParseError{Column: left.pos(), Message: fmt.Sprintf("a range needs a number on the left of 'in', got a %s", left.kind())}
+		}
+		return nil
+	case KindList:
+		if left.kind() != KindNumber && left.kind() != KindString {
+			return &ParseError{Column: left.pos(), Message: fmt.Sprintf("'in' needs a number or string on the left, got a %s", left.kind())}
+		}
+		return nil
+	}
+	return &ParseError{Column: right.pos(), Message: fmt.Sprintf("'%s' needs a list or range (e.g. 1990..1999) on the right, got a %s", opTok.text, right.kind())}
+}
@@ -0,0 +1,113 @@
+package expr
+
+import (
+	"sort"
+	"strings"
+)
+
+// Kind is the type of a value in an expression
+type Kind int
+
+const (
+	KindBool Kind = iota
+	KindNumber
+	KindString
+	KindList
+	kindRange
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindBool:
+		return "condition"
+	case KindNumber:
+		return "number"
+	case KindString:
+		return "string"
+	case KindList:
+		return "list"
+	}
+	return "range"
+}
+
+// Value is a typed value of a record field or literal
+type Value struct {
+	Kind   Kind
+	Bool   bool
+	Number float64
+	Str    string
+	List   []Value
+}
+
+func Bool(b bool) Value {
+	return Value{Kind: KindBool, Bool: b}
+}
+
+func Number(n float64) Value {
+	return Value{Kind: KindNumber, Number: n}
+}
+
+func String(s string) Value {
+	return Value{Kind: KindString, Str: s}
+}
+
+// Strings creates a list value from strings, e.g. genre or provider names
+func Strings(items []string) Value {
+	list := make([]Value, len(items))
+	for i, item := range items {
+		list[i] = String(item)
+	}
+	return Value{Kind: KindList, List: list}
+}
+
+func (v Value) equals(other Value) bool {
+	if v.Kind != other.Kind {
+		return false
+	}
+	switch v.Kind {
+	case KindNumber:
+		return v.Number == other.Number
+	case KindString:
+		return strings.EqualFold(v.Str, other.Str)
+	case KindBool:
+		return v.Bool == other.Bool
+	}
+	return false
+}
+
+// Record holds the field values an expression is evaluated against. Movies, shows
+// and their provider data are all mapped onto the same fields.
+type Record map[string]Value
+
+// Fields lists the record fields available in expressions and their types
+var Fields = map[string]Kind{
+	"title":          KindString,
+	"original_title": KindString,
+	"type":           KindString,
+	"language":       KindString,
+	"countries":      KindList,
+	"rating":         KindNumber,
+	"votes":          KindNumber,
+	"popularity":     KindNumber,
+	"year":           KindNumber,
+	"date":           KindString,
+	"runtime":        KindNumber,
+	"genres":         KindList,
+	"providers":      KindList,
+}
+
+// fieldAliases allows the singular form, e.g. `provider == "Netflix"`
+var fieldAliases = map[string]string{
+	"genre":    "genres",
+	"provider": "providers",
+	"country":  "countries",
+}
+
+func fieldNames() string {
+	names := make([]string, 0, len(Fields))
+	for name := range Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,63 @@
+package filters
+
+import (
+	"strconv"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// careerMinVotes keeps barely rated films out of the average and best-rated film
+const careerMinVotes = 50
+
+// CareerStats summarizes a person's films
+type CareerStats struct {
+	Films int
+	// RatedFilms is the number of films with enough votes for AverageRating and Best
+	RatedFilms    int
+	AverageRating float64
+	Best          *models.Movie
+	// FirstYear and LastYear are the active years, 0 if no film has a release date
+	FirstYear int
+	LastYear  int
+}
+
+// ComputeCareerStats summarizes the given films, e.g. the result of CreditsForRole
+func ComputeCareerStats(movies []models.Movie) CareerStats {
+	var stats CareerStats
+	var ratingSum float64
+
+	for i := range movies {
+		movie := &movies[i]
+		stats.Films++
+
+		if year, err := strconv.Atoi(yearOf(movie.ReleaseDate)); err == nil {
+			if stats.FirstYear == 0 || year < stats.FirstYear {
+				stats.FirstYear = year
+			}
+			if year > stats.LastYear {
+				stats.LastYear = year
+			}
+		}
+
+		if movie.VoteCount < careerMinVotes {
+			continue
+		}
+		stats.RatedFilms++
+		ratingSum += movie.VoteAverage
+		if stats.Best == nil || movie.VoteAverage > stats.Best.VoteAverage {
+			stats.Best = movie
+		}
+	}
+
+	if stats.RatedFilms > 0 {
+		stats.AverageRating = ratingSum / float64(stats.RatedFilms)
+	}
+	return stats
+}
+
+func yearOf(date string) string {
+	if len(date) < 4 {
+		return ""
+	}
+	return date[:4]
+}
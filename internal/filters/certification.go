@@ -0,0 +1,31 @@
+package filters
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// ParseMaxCertification interprets a --max-certification value for a region: either
+// an age ("12") or a certification of the region's rating system ("PG-13" in the US).
+// It returns the maximum age.
+func ParseMaxCertification(region, value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if age, err := strconv.Atoi(value); err == nil && age >= 0 {
+		return age, nil
+	}
+	if models.IsCertification(region, value) {
+		age, _ := models.CertificationAge(region, value)
+		return age, nil
+	}
+	return 0, fmt.Errorf("invalid certification %q for region %s: use an age (e.g. 12) or a certification of the region", value, strings.ToUpper(region))
+}
+
+// MeetsCertification reports whether a certification is suitable for maxAge.
+// Titles without a (known) certification in the region never match.
+func MeetsCertification(region, certification string, maxAge int) bool {
+	age, ok := models.CertificationAge(region, certification)
+	return ok && age <= maxAge
+}
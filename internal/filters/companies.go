@@ -0,0 +1,95 @@
+package filters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// MatchesCompanies reports whether any of the production companies is wanted.
+// An empty wanted list matches everything.
+func MatchesCompanies(companies []models.Company, wanted []models.Company) bool {
+	if len(wanted) == 0 {
+		return true
+	}
+	for _, company := range companies {
+		for _, w := range wanted {
+			if company.ID == w.ID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MatchesNetworks reports whether any of the networks is wanted.
+// An empty wanted list matches everything.
+func MatchesNetworks(networks []models.Network, wanted []models.Network) bool {
+	if len(wanted) == 0 {
+		return true
+	}
+	for _, network := range networks {
+		for _, w := range wanted {
+			if network.ID == w.ID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// BestCompanyMatch picks the company matching name exactly (case-insensitive) from
+// search results. Without an exact match the candidates are listed in the error.
+func BestCompanyMatch(name string, results []models.Company) (models.Company, error) {
+	for _, company := range results {
+		if strings.EqualFold(company.Name, name) {
+			return company, nil
+		}
+	}
+	if len(results) == 0 {
+		return models.Company{}, fmt.Errorf("unknown company %q", name)
+	}
+
+	candidates := make([]string, len(results))
+	for i, company := range results {
+		candidates[i] = fmt.Sprintf("%s (%d)", company.Name, company.ID)
+	}
+	return models.Company{}, fmt.Errorf("no exact match for company %q, use one of: %s", name, formatCandidates(candidates))
+}
+
+// CompanyIDs returns the company IDs in discover syntax ('|' = any)
+func CompanyIDs(companies []models.Company) string {
+	ids := make([]int, len(companies))
+	for i, company := range companies {
+		ids[i] = company.ID
+	}
+	return joinIDs(ids, "|")
+}
+
+// NetworkIDs returns the network IDs in discover syntax ('|' = any)
+func NetworkIDs(networks []models.Network) string {
+	ids := make([]int, len(networks))
+	for i, network := range networks {
+		ids[i] = network.ID
+	}
+	return joinIDs(ids, "|")
+}
+
+// CompanyNames joins company names for status messages
+func CompanyNames(companies []models.Company) string {
+	names := make([]string, len(companies))
+	for i, company := range companies {
+		names[i] = company.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// NetworkNames joins network names for status messages
+func NetworkNames(networks []models.Network) string {
+	names := make([]string, len(networks))
+	for i, network := range networks {
+		names[i] = network.Name
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,229 @@
+package filters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// Roles lists the roles accepted by --role
+var Roles = []string{"actor", "director", "writer", "composer", "any"}
+
+// ParseRole validates a --role value; an empty role means actor
+func ParseRole(role string) (string, error) {
+	role = strings.ToLower(strings.TrimSpace(role))
+	if role == "" {
+		return "actor", nil
+	}
+	for _, valid := range Roles {
+		if role == valid {
+			return role, nil
+		}
+	}
+	return "", fmt.Errorf("invalid role %q (use one of: %s)", role, strings.Join(Roles, ", "))
+}
+
+// MatchesRole reports whether a crew job belongs to the role
+func MatchesRole(role, department, job string) bool {
+	switch role {
+	case "any":
+		return true
+	case "director":
+		return job == "Director"
+	case "writer":
+		return department == "Writing"
+	case "composer":
+		return job == "Original Music Composer" || job == "Music" || job == "Composer"
+	}
+	return false
+}
+
+// CreditsForRole returns the person's movies in the given role in API order (cast first).
+// Movies credited more than once, e.g. as actor and director, are merged into one entry
+// keeping the character and joining the jobs.
+func CreditsForRole(credits *models.ActorCreditsResponse, role string) []models.Movie {
+	type creditKey struct {
+		mediaType string
+		id        int
+	}
+	var movies []models.Movie
+	index := make(map[creditKey]int)
+
+	add := func(movie models.Movie) {
+		key := creditKey{movie.MediaType, movie.ID}
+		i, seen := index[key]
+		if !seen {
+			index[key] = len(movies)
+			movies = append(movies, movie)
+			return
+		}
+		merged := &movies[i]
+		if merged.Character == "" {
+			merged.Character = movie.Character
+		}
+		if movie.EpisodeCount > merged.EpisodeCount {
+			merged.EpisodeCount = movie.EpisodeCount
+		}
+		if movie.Job != "" && !containsJob(merged.Job, movie.Job) {
+			if merged.Job == "" {
+				merged.Job = movie.Job
+			} else {
+				merged.Job += ", " + movie.Job
+			}
+		}
+	}
+
+	if role == "actor" || role == "any" {
+		for _, movie := range credits.Cast {
+			add(movie)
+		}
+	}
+	for _, movie := range credits.Crew {
+		if MatchesRole(role, movie.Department, movie.Job) {
+			add(movie)
+		}
+	}
+	return movies
+}
+
+func containsJob(jobs, job string) bool {
+	for _, j := range strings.Split(jobs, ", ") {
+		if j == job {
+			return true
+		}
+	}
+	return false
+}
+
+// Media lists the values accepted by --media
+var Media = []string{"movie", "tv", "all"}
+
+// ParseMedia validates a --media value; an empty value means all
+func ParseMedia(media string) (string, error) {
+	media = strings.ToLower(strings.TrimSpace(media))
+	if media == "" {
+		return "all", nil
+	}
+	for _, valid := range Media {
+		if media == valid {
+			return media, nil
+		}
+	}
+	return "", fmt.Errorf("invalid media %q (use one of: %s)", media, strings.Join(Media, ", "))
+}
+
+// Talk and news shows are skipped in filmographies, appearances there are mostly as oneself
+const (
+	tvGenreNews = 10763
+	tvGenreTalk = 10767
+)
+
+// SplitCredits splits combined credits into movies and shows, keeping those of the given media.
+// Credits without a media type are movie credits.
+func SplitCredits(credits []models.Movie, media string) (movies, shows []models.Movie) {
+	for _, credit := range credits {
+		if credit.IsShow() {
+			if media != "movie" && !isTalkOrNews(credit.GenreIDs) {
+				shows = append(shows, credit)
+			}
+			continue
+		}
+		if media != "tv" {
+			movies = append(movies, credit)
+		}
+	}
+	return movies, shows
+}
+
+func isTalkOrNews(genreIDs []int) bool {
+	for _, id := range genreIDs {
+		if id == tvGenreNews || id == tvGenreTalk {
+			return true
+		}
+	}
+	return false
+}
+
+// SharedCredit is a title several people worked on, with each person's (merged) credit
+type SharedCredit struct {
+	Title   models.Movie
+	Credits []models.Movie
+}
+
+// SharedCredits intersects the filmographies (see CreditsForRole) of several people,
+// keeping the order of the first one
+func SharedCredits(filmographies [][]models.Movie) []SharedCredit {
+	if len(filmographies) == 0 {
+		return nil
+	}
+
+	type creditKey struct {
+		mediaType string
+		id        int
+	}
+	indices := make([]map[creditKey]int, len(filmographies))
+	for i, filmography := range filmographies {
+		indices[i] = make(map[creditKey]int)
+		for j, credit := range filmography {
+			indices[i][creditKey{credit.MediaType, credit.ID}] = j
+		}
+	}
+
+	var shared []SharedCredit
+	for _, title := range filmographies[0] {
+		key := creditKey{title.MediaType, title.ID}
+		credits := make([]models.Movie, 0, len(filmographies))
+		for i, filmography := range filmographies {
+			j, ok := indices[i][key]
+			if !ok {
+				break
+			}
+			credits = append(credits, filmography[j])
+		}
+		if len(credits) == len(filmographies) {
+			shared = append(shared, SharedCredit{Title: title, Credits: credits})
+		}
+	}
+	return shared
+}
+
+// CreditDescription describes a credit as character and jobs, e.g. "Bill Munny; Director"
+func CreditDescription(credit models.Movie) string {
+	var parts []string
+	if credit.Character != "" {
+		parts = append(parts, credit.Character)
+	}
+	if credit.Job != "" {
+		parts = append(parts, credit.Job)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// RoleVerb describes the role in summaries, e.g. "directed by"
+func RoleVerb(role string) string {
+	switch role {
+	case "director":
+		return "directed by"
+	case "writer":
+		return "written by"
+	case "composer":
+		return "scored by"
+	case "any":
+		return "with"
+	}
+	return "starring"
+}
+
+// RoleForDepartment picks the role for a person's known-for department, e.g. "Directing"
+func RoleForDepartment(department string) string {
+	switch department {
+	case "Directing":
+		return "director"
+	case "Writing":
+		return "writer"
+	case "Sound":
+		return "composer"
+	}
+	return "actor"
+}
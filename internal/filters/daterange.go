@@ -0,0 +1,181 @@
+package filters
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// DateRange is an inclusive range of release dates. A zero From or To leaves
+// that side of the range open.
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// ParseDateRange combines the --year, --from, --to and --decade flags into a single
+// range. When several are given, the intersection is used.
+func ParseDateRange(year int, from, to, decade string) (DateRange, error) {
+	var r DateRange
+
+	if year > 0 {
+		r = r.intersect(yearRange(year))
+	}
+
+	if from != "" {
+		start, err := parseDateBound(from, false)
+		if err != nil {
+			return DateRange{}, fmt.Errorf("invalid --from value %q: %w", from, err)
+		}
+		r = r.intersect(DateRange{From: start})
+	}
+
+	if to != "" {
+		end, err := parseDateBound(to, true)
+		if err != nil {
+			return DateRange{}, fmt.Errorf("invalid --to value %q: %w", to, err)
+		}
+		r = r.intersect(DateRange{To: end})
+	}
+
+	if decade != "" {
+		d, err := parseDecade(decade)
+		if err != nil {
+			return DateRange{}, err
+		}
+		r = r.intersect(d)
+	}
+
+	if !r.From.IsZero() && !r.To.IsZero() && r.From.After(r.To) {
+		return DateRange{}, fmt.Errorf("date range is empty: %s is after %s", r.FromString(), r.ToString())
+	}
+
+	return r, nil
+}
+
+// IsZero reports whether the range does not restrict anything
+func (r DateRange) IsZero() bool {
+	return r.From.IsZero() && r.To.IsZero()
+}
+
+// Contains reports whether a TMDB date (YYYY-MM-DD) lies within the range.
+// Unknown dates only match an unrestricted range.
+func (r DateRange) Contains(date string) bool {
+	if r.IsZero() {
+		return true
+	}
+
+	if len(date) > len(dateLayout) {
+		date = date[:len(dateLayout)]
+	}
+	t, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return false
+	}
+
+	if !r.From.IsZero() && t.Before(r.From) {
+		return false
+	}
+	if !r.To.IsZero() && t.After(r.To) {
+		return false
+	}
+	return true
+}
+
+// FromString returns the start of the range as YYYY-MM-DD or "" if open
+func (r DateRange) FromString() string {
+	if r.From.IsZero() {
+		return ""
+	}
+	return r.From.Format(dateLayout)
+}
+
+// ToString returns the end of the range as YYYY-MM-DD or "" if open
+func (r DateRange) ToString() string {
+	if r.To.IsZero() {
+		return ""
+	}
+	return r.To.Format(dateLayout)
+}
+
+// String describes the range for status messages, e.g. "1990-01-01 to 1999-12-31"
+func (r DateRange) String() string {
+	switch {
+	case r.IsZero():
+		return "any time"
+	case r.From.IsZero():
+		return "until " + r.ToString()
+	case r.To.IsZero():
+		return "since " + r.FromString()
+	}
+	return r.FromString() + " to " + r.ToString()
+}
+
+func (r DateRange) intersect(other DateRange) DateRange {
+	if r.From.IsZero() || other.From.After(r.From) {
+		r.From = other.From
+	}
+	if r.To.IsZero() || (!other.To.IsZero() && other.To.Before(r.To)) {
+		r.To = other.To
+	}
+	return r
+}
+
+func yearRange(year int) DateRange {
+	return DateRange{
+		From: time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// parseDateBound accepts a year (1990) or a full date (1990-05-17). A year is
+// expanded to its first day for lower bounds and its last day for upper bounds.
+func parseDateBound(value string, upper bool) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	if year, err := strconv.Atoi(value); err == nil && len(value) == 4 {
+		r := yearRange(year)
+		if upper {
+			return r.To, nil
+		}
+		return r.From, nil
+	}
+
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected a year (1990) or a date (1990-05-17)")
+	}
+	return t, nil
+}
+
+// parseDecade accepts "1990s", "1990" and "90s"
+func parseDecade(value string) (DateRange, error) {
+	trimmed := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(value)), "s")
+	trimmed = strings.TrimSuffix(trimmed, "'")
+
+	year, err := strconv.Atoi(trimmed)
+	if err != nil || year < 0 {
+		return DateRange{}, fmt.Errorf("invalid --decade value %q: expected e.g. 1990s or 90s", value)
+	}
+
+	switch {
+	case len(trimmed) == 2 && year <= time.Now().Year()%100:
+		year += 2000
+	case len(trimmed) == 2:
+		year += 1900
+	case len(trimmed) != 4:
+		return DateRange{}, fmt.Errorf("invalid --decade value %q: expected e.g. 1990s or 90s", value)
+	}
+
+	if year%10 != 0 {
+		return DateRange{}, fmt.Errorf("invalid --decade value %q: a decade starts with a year ending in 0", value)
+	}
+
+	return DateRange{
+		From: yearRange(year).From,
+		To:   yearRange(year + 9).To,
+	}, nil
+}
@@ -33,6 +33,18 @@ func CheckAvailability(providerData models.RegionProviders, desiredProviders map
 	return available, len(available) > 0
 }
 
+// ProviderIDs returns the IDs of the desired providers in discover syntax ('|' = OR),
+// empty if none of them is in the list
+func ProviderIDs(providers []models.Provider, desiredProviders map[string]bool) string {
+	ids := []int{}
+	for _, p := range providers {
+		if isProviderMatched(p.ProviderName, desiredProviders) {
+			ids = append(ids, p.ProviderID)
+		}
+	}
+	return joinIDs(ids, "|")
+}
+
 func isProviderMatched(providerName string, desiredProviders map[string]bool) bool {
 	providerLower := strings.ToLower(providerName)
 
@@ -92,11 +104,14 @@ func FilterByGenre(movie *models.Movie, desiredGenre string, genreMap map[string
 	return false
 }
 
-// BuildGenreMap creates a map of genre names to IDs for quick lookup
-func BuildGenreMap(genres []models.Genre) map[string]int {
+// BuildGenreMap creates a map of genre names to IDs for quick lookup. Several lists
+// (e.g. the localized and the English names) can be merged into one map.
+func BuildGenreMap(lists ...[]models.Genre) map[string]int {
 	genreMap := make(map[string]int)
-	for _, genre := range genres {
-		genreMap[strings.ToLower(genre.Name)] = genre.ID
+	for _, genres := range lists {
+		for _, genre := range genres {
+			genreMap[strings.ToLower(genre.Name)] = genre.ID
+		}
 	}
 	return genreMap
 }
@@ -116,3 +131,20 @@ func GetGenreNames(genreIDs []int, genres []models.Genre) []string {
 	}
 	return names
 }
+
+// FilterMultiSearchResults keeps results of the given media type ("" for all) whose
+// release or first air date lies in dateRange. People have no date and are not affected by it.
+func FilterMultiSearchResults(results []models.MultiSearchResult, mediaType string, dateRange DateRange) []models.MultiSearchResult {
+	filtered := make([]models.MultiSearchResult, 0, len(results))
+
+	for _, result := range results {
+		if mediaType != "" && result.MediaType != mediaType {
+			continue
+		}
+		if result.MediaType != models.MediaTypePerson && !dateRange.Contains(result.GetDate()) {
+			continue
+		}
+		filtered = append(filtered, result)
+	}
+	return filtered
+}
@@ -0,0 +1,175 @@
+package filters
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// GenreFilter matches titles by genre. Include is either matched as "any of"
+// ("Action,Thriller") or "all of" ("Drama+Romance"); Exclude drops titles having any of
+// its genres. All genres are resolved to IDs so the filter can be pushed down to discover.
+type GenreFilter struct {
+	Include  []int
+	MatchAll bool
+	Exclude  []int
+
+	includeNames []string
+	excludeNames []string
+}
+
+// ParseGenreFilter parses the --genre and --exclude-genre values. Genres may be given
+// by name or ID. TV genre names like "Action & Adventure" can be referred to by either part.
+func ParseGenreFilter(include, exclude string, genreMap map[string]int) (GenreFilter, error) {
+	var filter GenreFilter
+
+	separator := ","
+	if strings.Contains(include, "+") {
+		if strings.Contains(include, ",") {
+			return GenreFilter{}, fmt.Errorf("invalid --genre value %q: use either ',' (any) or '+' (all)", include)
+		}
+		separator = "+"
+		filter.MatchAll = true
+	}
+
+	var err error
+	filter.Include, filter.includeNames, err = resolveGenres(include, separator, genreMap)
+	if err != nil {
+		return GenreFilter{}, err
+	}
+
+	filter.Exclude, filter.excludeNames, err = resolveGenres(exclude, ",", genreMap)
+	if err != nil {
+		return GenreFilter{}, err
+	}
+
+	return filter, nil
+}
+
+// IsZero reports whether the filter does not restrict anything
+func (g GenreFilter) IsZero() bool {
+	return len(g.Include) == 0 && len(g.Exclude) == 0
+}
+
+// Matches checks the genre IDs of a title against the filter
+func (g GenreFilter) Matches(genreIDs []int) bool {
+	has := make(map[int]bool, len(genreIDs))
+	for _, id := range genreIDs {
+		has[id] = true
+	}
+
+	for _, id := range g.Exclude {
+		if has[id] {
+			return false
+		}
+	}
+
+	if len(g.Include) == 0 {
+		return true
+	}
+
+	for _, id := range g.Include {
+		if g.MatchAll && !has[id] {
+			return false
+		}
+		if !g.MatchAll && has[id] {
+			return true
+		}
+	}
+	return g.MatchAll
+}
+
+// WithGenres returns the include list in discover syntax (',' = AND, '|' = OR)
+func (g GenreFilter) WithGenres() string {
+	separator := "|"
+	if g.MatchAll {
+		separator = ","
+	}
+	return joinIDs(g.Include, separator)
+}
+
+// WithoutGenres returns the exclude list in discover syntax
+func (g GenreFilter) WithoutGenres() string {
+	return joinIDs(g.Exclude, ",")
+}
+
+// String describes the filter for status messages, e.g. "Drama and Romance, not Horror"
+func (g GenreFilter) String() string {
+	parts := []string{}
+	if len(g.includeNames) > 0 {
+		conjunction := " or "
+		if g.MatchAll {
+			conjunction = " and "
+		}
+		parts = append(parts, strings.Join(g.includeNames, conjunction))
+	}
+	if len(g.excludeNames) > 0 {
+		parts = append(parts, "not "+strings.Join(g.excludeNames, ", "))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// GetGenreIDs returns the genre IDs of a movie from either genre_ids (lists) or genres (details)
+func GetGenreIDs(movie *models.Movie) []int {
+	if len(movie.GenreIDs) > 0 {
+		return movie.GenreIDs
+	}
+	ids := make([]int, len(movie.Genres))
+	for i, genre := range movie.Genres {
+		ids[i] = genre.ID
+	}
+	return ids
+}
+
+func resolveGenres(input, separator string, genreMap map[string]int) ([]int, []string, error) {
+	ids := []int{}
+	names := []string{}
+
+	for _, part := range strings.Split(input, separator) {
+		name := strings.TrimSpace(part)
+		if name == "" {
+			continue
+		}
+
+		id, ok := resolveGenre(name, genreMap)
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown genre %q (see 'tmdb genres')", name)
+		}
+		ids = append(ids, id)
+		names = append(names, name)
+	}
+
+	return ids, names, nil
+}
+
+func resolveGenre(name string, genreMap map[string]int) (int, bool) {
+	if id, err := strconv.Atoi(name); err == nil {
+		return id, true
+	}
+
+	nameLower := strings.ToLower(name)
+	if id, ok := genreMap[nameLower]; ok {
+		return id, true
+	}
+
+	// TV genres combine names, e.g. "Sci-Fi & Fantasy" or "Action & Adventure"
+	for genreName, id := range genreMap {
+		for _, part := range strings.Split(genreName, "&") {
+			if strings.TrimSpace(part) == nameLower {
+				return id, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func joinIDs(ids []int, separator string) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, separator)
+}
@@ -0,0 +1,114 @@
+package filters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// KeywordFilter matches titles having any of the Include keywords and none of the Exclude keywords
+type KeywordFilter struct {
+	Include []models.Keyword
+	Exclude []models.Keyword
+}
+
+// IsZero reports whether no keyword filter is set
+func (k KeywordFilter) IsZero() bool {
+	return len(k.Include) == 0 && len(k.Exclude) == 0
+}
+
+// Matches checks the keyword IDs of a title against the filter
+func (k KeywordFilter) Matches(keywordIDs []int) bool {
+	has := make(map[int]bool, len(keywordIDs))
+	for _, id := range keywordIDs {
+		has[id] = true
+	}
+
+	for _, keyword := range k.Exclude {
+		if has[keyword.ID] {
+			return false
+		}
+	}
+
+	if len(k.Include) == 0 {
+		return true
+	}
+	for _, keyword := range k.Include {
+		if has[keyword.ID] {
+			return true
+		}
+	}
+	return false
+}
+
+// WithKeywords returns the include list in discover syntax ('|' = any)
+func (k KeywordFilter) WithKeywords() string {
+	return joinIDs(models.KeywordIDs(k.Include), "|")
+}
+
+// WithoutKeywords returns the exclude list in discover syntax
+func (k KeywordFilter) WithoutKeywords() string {
+	return joinIDs(models.KeywordIDs(k.Exclude), ",")
+}
+
+// String describes the filter for status messages, e.g. "heist or time travel, not based on novel or book"
+func (k KeywordFilter) String() string {
+	parts := []string{}
+	if len(k.Include) > 0 {
+		parts = append(parts, strings.Join(keywordNames(k.Include), " or "))
+	}
+	if len(k.Exclude) > 0 {
+		parts = append(parts, "not "+strings.Join(keywordNames(k.Exclude), " or "))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func keywordNames(keywords []models.Keyword) []string {
+	names := make([]string, len(keywords))
+	for i, keyword := range keywords {
+		names[i] = keyword.Name
+	}
+	return names
+}
+
+// SplitNames splits a comma-separated list of names (keywords, companies, ...), dropping empty entries
+func SplitNames(input string) []string {
+	names := []string{}
+	for _, part := range strings.Split(input, ",") {
+		if name := strings.TrimSpace(part); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// BestKeywordMatch picks the keyword matching name exactly (case-insensitive) from
+// search results. Without an exact match the candidates are listed in the error.
+func BestKeywordMatch(name string, results []models.Keyword) (models.Keyword, error) {
+	for _, keyword := range results {
+		if strings.EqualFold(keyword.Name, name) {
+			return keyword, nil
+		}
+	}
+	if len(results) == 0 {
+		return models.Keyword{}, fmt.Errorf("unknown keyword %q", name)
+	}
+
+	candidates := make([]string, len(results))
+	for i, keyword := range results {
+		candidates[i] = fmt.Sprintf("%s (%d)", keyword.Name, keyword.ID)
+	}
+	return models.Keyword{}, fmt.Errorf("no exact match for keyword %q, use one of: %s", name, formatCandidates(candidates))
+}
+
+// maxCandidates limits the candidates listed in an ambiguity error
+const maxCandidates = 10
+
+// formatCandidates joins the first maxCandidates candidates for an error message
+func formatCandidates(candidates []string) string {
+	if len(candidates) <= maxCandidates {
+		return strings.Join(candidates, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(candidates[:maxCandidates], ", "), len(candidates)-maxCandidates)
+}
@@ -0,0 +1,70 @@
+package filters
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseLanguageCodes parses a comma-separated list of ISO 639-1 language codes (e.g. "ko,ja")
+func ParseLanguageCodes(input string) ([]string, error) {
+	return parseCodes(input, "language", "ko", strings.ToLower)
+}
+
+// ParseCountryCodes parses a comma-separated list of ISO 3166-1 country codes (e.g. "KR,JP")
+func ParseCountryCodes(input string) ([]string, error) {
+	return parseCodes(input, "country", "KR", strings.ToUpper)
+}
+
+func parseCodes(input, kind, example string, normalize func(string) string) ([]string, error) {
+	codes := []string{}
+	for _, part := range strings.Split(input, ",") {
+		code := strings.TrimSpace(part)
+		if code == "" {
+			continue
+		}
+		if len(code) != 2 || !isLetters(code) {
+			return nil, fmt.Errorf("invalid %s code %q: expected two letters (e.g. %s)", kind, code, example)
+		}
+		codes = append(codes, normalize(code))
+	}
+	return codes, nil
+}
+
+func isLetters(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchesLanguage reports whether the original language is one of the given codes.
+// An empty code list matches everything.
+func MatchesLanguage(language string, languages []string) bool {
+	if len(languages) == 0 {
+		return true
+	}
+	for _, code := range languages {
+		if strings.EqualFold(language, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesCountry reports whether any of the origin countries is one of the given codes.
+// An empty code list matches everything.
+func MatchesCountry(countries []string, codes []string) bool {
+	if len(codes) == 0 {
+		return true
+	}
+	for _, country := range countries {
+		for _, code := range codes {
+			if strings.EqualFold(country, code) {
+				return true
+			}
+		}
+	}
+	return false
+}
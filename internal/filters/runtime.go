@@ -0,0 +1,84 @@
+package filters
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// MeetsRuntimeCriteria checks a runtime in minutes against optional bounds (0 = no bound).
+// An unknown runtime (0) only passes when no bound is set.
+func MeetsRuntimeCriteria(runtime, minRuntime, maxRuntime int) bool {
+	if minRuntime <= 0 && maxRuntime <= 0 {
+		return true
+	}
+	if runtime <= 0 {
+		return false
+	}
+	if minRuntime > 0 && runtime < minRuntime {
+		return false
+	}
+	if maxRuntime > 0 && runtime > maxRuntime {
+		return false
+	}
+	return true
+}
+
+// ParseTimeBudget parses a time budget like "2h30m", "90m" or "150" (minutes) into minutes
+func ParseTimeBudget(input string) (int, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, nil
+	}
+
+	if minutes, err := strconv.Atoi(input); err == nil {
+		if minutes <= 0 {
+			return 0, fmt.Errorf("invalid time budget %q: must be positive", input)
+		}
+		return minutes, nil
+	}
+
+	duration, err := time.ParseDuration(input)
+	if err != nil || duration < time.Minute {
+		return 0, fmt.Errorf("invalid time budget %q: expected e.g. 2h30m, 90m or 150", input)
+	}
+	return int(duration.Minutes()), nil
+}
+
+// SelectWithinBudget picks the best rated movies whose runtimes add up to at most
+// budget minutes, rated by the scoring mode. It returns the indices of the selected
+// movies, best rated first. Movies without a known runtime are never selected.
+func SelectWithinBudget(movies []models.Movie, budget int, scoring Scoring) []int {
+	order := make([]int, len(movies))
+	for i := range movies {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := movies[order[i]], movies[order[j]]
+		return scoring.Rating(a.VoteAverage, a.VoteCount) > scoring.Rating(b.VoteAverage, b.VoteCount)
+	})
+
+	selected := []int{}
+	remaining := budget
+	for _, i := range order {
+		runtime := movies[i].Runtime
+		if runtime <= 0 || runtime > remaining {
+			continue
+		}
+		selected = append(selected, i)
+		remaining -= runtime
+	}
+	return selected
+}
+
+// EpisodesWithinBudget returns how many episodes of the given runtime fit into budget minutes
+func EpisodesWithinBudget(episodeRuntime, budget int) int {
+	if episodeRuntime <= 0 {
+		return 0
+	}
+	return budget / episodeRuntime
+}
@@ -0,0 +1,136 @@
+package filters
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// gemPopularityCeiling is the TMDB popularity at which a title counts as fully mainstream
+const gemPopularityCeiling = 100.0
+
+// Scoring modes accepted by --scoring
+const (
+	ScoringRaw      = "raw"
+	ScoringWeighted = "weighted"
+)
+
+// Scoring holds the prior of the Bayesian weighted rating: ratings with few votes
+// are shrunk toward PriorMean, PriorVotes being the weight of the prior in votes.
+// With Weighted set, rating filters and sorting use the weighted rating instead of
+// the raw vote average and a hard minimum vote count.
+type Scoring struct {
+	PriorMean  float64
+	PriorVotes int
+	Weighted   bool
+}
+
+// ParseScoringMode reports whether the --scoring mode is weighted; empty means raw
+func ParseScoringMode(mode string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", ScoringRaw:
+		return false, nil
+	case ScoringWeighted:
+		return true, nil
+	}
+	return false, fmt.Errorf("invalid scoring mode %q (use %s or %s)", mode, ScoringRaw, ScoringWeighted)
+}
+
+// Rating returns the rating used for filtering and sorting: the weighted rating in
+// weighted mode, the raw vote average otherwise
+func (s Scoring) Rating(average float64, votes int) float64 {
+	if s.Weighted {
+		return s.WeightedRating(average, votes)
+	}
+	return average
+}
+
+// MeetsRatingCriteria checks the rating thresholds. In weighted mode the weighted
+// rating must reach minRating and minVotes is ignored, since the prior already
+// discounts titles with few votes.
+func (s Scoring) MeetsRatingCriteria(average float64, votes int, minRating float64, minVotes int) bool {
+	if s.Weighted {
+		return s.WeightedRating(average, votes) >= minRating
+	}
+	return MeetsRatingCriteria(average, votes, minRating, minVotes)
+}
+
+// WeightedRating returns the IMDb-style weighted rating
+//
+//	(v / (v+m)) * R + (m / (v+m)) * C
+//
+// with R the vote average, v the vote count, C the prior mean and m the prior votes
+func (s Scoring) WeightedRating(average float64, votes int) float64 {
+	v := float64(votes)
+	m := float64(s.PriorVotes)
+	if v+m == 0 {
+		return average
+	}
+	return (v/(v+m))*average + (m/(v+m))*s.PriorMean
+}
+
+// HiddenGemScore combines the weighted rating with low popularity. A title with a
+// popularity of 0 keeps its full weighted rating; at gemPopularityCeiling and above
+// the score is halved.
+func (s Scoring) HiddenGemScore(average float64, votes int, popularity float64) float64 {
+	mainstream := math.Log10(1+math.Max(popularity, 0)) / math.Log10(1+gemPopularityCeiling)
+	return s.WeightedRating(average, votes) * (1 - 0.5*math.Min(mainstream, 1))
+}
+
+// SortKeys lists the keys accepted by --sort
+var SortKeys = []string{"rating", "votes", "popularity", "date", "title", "runtime", "gem"}
+
+// ParseSortKey validates a --sort key; an empty key keeps the API order
+func ParseSortKey(key string) (string, error) {
+	key = strings.ToLower(strings.TrimSpace(key))
+	if key == "" {
+		return "", nil
+	}
+	for _, valid := range SortKeys {
+		if key == valid {
+			return key, nil
+		}
+	}
+	return "", fmt.Errorf("invalid sort key %q (use one of: %s)", key, strings.Join(SortKeys, ", "))
+}
+
+// SortMovies orders the given movie indices by key, best (or newest) first.
+// Titles are sorted alphabetically; "rating" uses the weighted rating in weighted mode. The sort is stable, so ties keep the API order.
+func SortMovies(movies []models.Movie, indices []int, key string, scoring Scoring) {
+	value := func(m *models.Movie) float64 {
+		switch key {
+		case "votes":
+			return float64(m.VoteCount)
+		case "popularity":
+			return m.Popularity
+		case "runtime":
+			return float64(m.Runtime)
+		case "gem":
+			return scoring.HiddenGemScore(m.VoteAverage, m.VoteCount, m.Popularity)
+		}
+		return scoring.Rating(m.VoteAverage, m.VoteCount)
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		a, b := &movies[indices[i]], &movies[indices[j]]
+		switch key {
+		case "":
+			return false
+		case "title":
+			return strings.ToLower(a.GetTitle()) < strings.ToLower(b.GetTitle())
+		case "date":
+			return movieDate(a) > movieDate(b)
+		}
+		return value(a) > value(b)
+	})
+}
+
+func movieDate(m *models.Movie) string {
+	if m.ReleaseDate != "" {
+		return m.ReleaseDate
+	}
+	return m.FirstAirDate
+}
@@ -0,0 +1,37 @@
+package filters
+
+import "strconv"
+
+// SeenFilter hides the titles of the seen history from results
+type SeenFilter struct {
+	titles map[string]bool
+}
+
+// NewSeenFilter creates a filter hiding the given titles
+func NewSeenFilter() SeenFilter {
+	return SeenFilter{titles: make(map[string]bool)}
+}
+
+// Add hides a movie or show
+func (s SeenFilter) Add(mediaType string, id int) {
+	s.titles[seenKey(mediaType, id)] = true
+}
+
+// IsZero reports whether no titles are hidden
+func (s SeenFilter) IsZero() bool {
+	return len(s.titles) == 0
+}
+
+// Len returns the number of hidden titles
+func (s SeenFilter) Len() int {
+	return len(s.titles)
+}
+
+// Contains reports whether a title was seen
+func (s SeenFilter) Contains(mediaType string, id int) bool {
+	return s.titles[seenKey(mediaType, id)]
+}
+
+func seenKey(mediaType string, id int) string {
+	return mediaType + ":" + strconv.Itoa(id)
+}
@@ -0,0 +1,63 @@
+package filters
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// TimelineYear is one year of a career timeline
+type TimelineYear struct {
+	Year          int
+	AverageRating float64
+	Films         []models.Movie
+}
+
+// BuildTimeline groups the rated films (see careerMinVotes) by release year, oldest first.
+// Years without rated films are left out.
+func BuildTimeline(movies []models.Movie) []TimelineYear {
+	byYear := make(map[int]*TimelineYear)
+	for _, movie := range movies {
+		if movie.VoteCount < careerMinVotes {
+			continue
+		}
+		year, err := strconv.Atoi(yearOf(movie.ReleaseDate))
+		if err != nil {
+			continue
+		}
+		if byYear[year] == nil {
+			byYear[year] = &TimelineYear{Year: year}
+		}
+		byYear[year].Films = append(byYear[year].Films, movie)
+	}
+
+	timeline := make([]TimelineYear, 0, len(byYear))
+	for _, year := range byYear {
+		var sum float64
+		for _, film := range year.Films {
+			sum += film.VoteAverage
+		}
+		year.AverageRating = sum / float64(len(year.Films))
+		timeline = append(timeline, *year)
+	}
+	sort.Slice(timeline, func(i, j int) bool {
+		return timeline[i].Year < timeline[j].Year
+	})
+	return timeline
+}
+
+// TopRatedFilms returns the n best rated films of the timeline, best first
+func TopRatedFilms(timeline []TimelineYear, n int) []models.Movie {
+	var films []models.Movie
+	for _, year := range timeline {
+		films = append(films, year.Films...)
+	}
+	sort.SliceStable(films, func(i, j int) bool {
+		return films[i].VoteAverage > films[j].VoteAverage
+	})
+	if len(films) > n {
+		films = films[:n]
+	}
+	return films
+}
@@ -0,0 +1,226 @@
+package graph
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// Node kinds
+const (
+	KindPerson = "person"
+	KindMovie  = "movie"
+)
+
+// Node is a person or, in bipartite graphs, a movie
+type Node struct {
+	ID     string
+	Kind   string
+	Label  string
+	TmdbID int
+	// Rating and Year are only set for movies
+	Rating float64
+	Year   string
+}
+
+// Edge connects two nodes. In co-star graphs Weight is the number of shared films.
+type Edge struct {
+	From   string
+	To     string
+	Weight int
+}
+
+// Graph is a collaboration network built from credits
+type Graph struct {
+	Nodes []Node
+	Edges []Edge
+	// Truncated is set when the request budget ran out before the graph was complete
+	Truncated bool
+}
+
+// BuildOptions limit the graph
+type BuildOptions struct {
+	// Depth is the number of co-star hops from the root person
+	Depth int
+	// Bipartite builds a person-movie graph instead of a co-star graph
+	Bipartite bool
+	// MaxMoviesPerPerson and MaxCastPerMovie work like in Options
+	MaxMoviesPerPerson int
+	MaxCastPerMovie    int
+	// Allow prunes movies, e.g. low rated or obscure ones
+	Allow func(models.Movie) (bool, error)
+}
+
+// Build collects the movies of the root person and, up to the given depth, of their
+// co-stars breadth-first. When the memo's budget runs out the graph built so far is
+// returned with Truncated set.
+func Build(memo *Memo, root Person, opts BuildOptions) (*Graph, error) {
+	b := &builder{
+		graph:   &Graph{},
+		nodes:   make(map[string]bool),
+		edges:   make(map[[2]string]int),
+		movies:  make(map[int]bool),
+		visited: map[int]bool{root.ID: true},
+	}
+	b.addPerson(root.ID, root.Name)
+
+	frontier := []int{root.ID}
+	for depth := 0; depth < opts.Depth && len(frontier) > 0; depth++ {
+		var next []int
+		for _, personID := range frontier {
+			discovered, err := b.expand(memo, personID, opts)
+			if errors.Is(err, ErrBudgetExceeded) {
+				b.graph.Truncated = true
+				return b.finish(), nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, discovered...)
+		}
+		frontier = next
+	}
+	return b.finish(), nil
+}
+
+type builder struct {
+	graph *Graph
+	nodes map[string]bool
+	edges map[[2]string]int
+	order [][2]string
+	// movies holds the movies already added or pruned, visited the people already discovered
+	movies  map[int]bool
+	visited map[int]bool
+}
+
+// expand adds the allowed movies of a person and returns the newly discovered co-stars
+func (b *builder) expand(memo *Memo, personID int, opts BuildOptions) ([]int, error) {
+	movies, err := memo.Credits(personID)
+	if err != nil {
+		return nil, err
+	}
+
+	var discovered []int
+	for _, movie := range expansionMovies(movies, opts.MaxMoviesPerPerson) {
+		// Movies shared with an already expanded person are added (or pruned) only once
+		if b.movies[movie.ID] {
+			continue
+		}
+		if opts.Allow != nil {
+			allowed, err := opts.Allow(movie)
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				b.movies[movie.ID] = true
+				continue
+			}
+		}
+
+		cast, err := memo.Cast(movie.ID)
+		if err != nil {
+			return nil, err
+		}
+		b.movies[movie.ID] = true
+
+		members := withPerson(topBilled(cast, opts.MaxCastPerMovie), cast, personID)
+		for _, member := range members {
+			b.addPerson(member.ID, member.Name)
+			if !b.visited[member.ID] {
+				b.visited[member.ID] = true
+				discovered = append(discovered, member.ID)
+			}
+		}
+
+		if opts.Bipartite {
+			movieNode := b.addMovie(movie)
+			for _, member := range members {
+				b.addEdge(personNodeID(member.ID), movieNode)
+			}
+			continue
+		}
+		for i := range members {
+			for j := i + 1; j < len(members); j++ {
+				b.addEdge(personNodeID(members[i].ID), personNodeID(members[j].ID))
+			}
+		}
+	}
+	return discovered, nil
+}
+
+// withPerson adds the expanded person to the top billed members if they are billed
+// below the cutoff, so every film of theirs links them to their co-stars
+func withPerson(members, cast []models.CastMember, personID int) []models.CastMember {
+	for _, member := range members {
+		if member.ID == personID {
+			return members
+		}
+	}
+	person := models.CastMember{ID: personID}
+	for _, member := range cast {
+		if member.ID == personID {
+			person = member
+			break
+		}
+	}
+	return append(members, person)
+}
+
+func (b *builder) addPerson(id int, name string) {
+	nodeID := personNodeID(id)
+	if b.nodes[nodeID] {
+		return
+	}
+	b.nodes[nodeID] = true
+	b.graph.Nodes = append(b.graph.Nodes, Node{ID: nodeID, Kind: KindPerson, Label: name, TmdbID: id})
+}
+
+func (b *builder) addMovie(movie models.Movie) string {
+	nodeID := "m" + strconv.Itoa(movie.ID)
+	if !b.nodes[nodeID] {
+		b.nodes[nodeID] = true
+		b.graph.Nodes = append(b.graph.Nodes, Node{
+			ID:     nodeID,
+			Kind:   KindMovie,
+			Label:  movie.GetTitle(),
+			TmdbID: movie.ID,
+			Rating: movie.VoteAverage,
+			Year:   yearOf(movie.ReleaseDate),
+		})
+	}
+	return nodeID
+}
+
+// addEdge counts a shared film between two nodes, in either direction
+func (b *builder) addEdge(from, to string) {
+	if from == to {
+		return
+	}
+	key := [2]string{from, to}
+	if to < from {
+		key = [2]string{to, from}
+	}
+	if b.edges[key] == 0 {
+		b.order = append(b.order, key)
+	}
+	b.edges[key]++
+}
+
+func (b *builder) finish() *Graph {
+	for _, key := range b.order {
+		b.graph.Edges = append(b.graph.Edges, Edge{From: key[0], To: key[1], Weight: b.edges[key]})
+	}
+	return b.graph
+}
+
+func personNodeID(id int) string {
+	return "p" + strconv.Itoa(id)
+}
+
+func yearOf(date string) string {
+	if len(date) < 4 {
+		return ""
+	}
+	return date[:4]
+}
@@ -0,0 +1,148 @@
+package graph
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Formats lists the export formats accepted by Write
+var Formats = []string{"dot", "graphml", "json"}
+
+// ParseFormat validates an export format
+func ParseFormat(format string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	for _, valid := range Formats {
+		if format == valid {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("invalid format %q (use one of: %s)", format, strings.Join(Formats, ", "))
+}
+
+// Write exports the graph in the given format
+func Write(w io.Writer, g *Graph, format string) error {
+	switch strings.ToLower(format) {
+	case "dot":
+		return WriteDOT(w, g)
+	case "graphml":
+		return WriteGraphML(w, g)
+	case "json":
+		return WriteJSON(w, g)
+	}
+	return fmt.Errorf("invalid format %q (use one of: %s)", format, strings.Join(Formats, ", "))
+}
+
+// WriteDOT exports the graph as an undirected Graphviz graph. People are ellipses,
+// movies boxes, and edges are labeled with their weight if above one.
+func WriteDOT(w io.Writer, g *Graph) error {
+	var b strings.Builder
+	b.WriteString("graph tmdb {\n")
+	b.WriteString("  node [shape=ellipse];\n")
+	for _, node := range g.Nodes {
+		label := node.Label
+		shape := ""
+		if node.Kind == KindMovie {
+			shape = ", shape=box"
+			if node.Year != "" {
+				label += " (" + node.Year + ")"
+			}
+		}
+		fmt.Fprintf(&b, "  %q [label=%q%s];\n", node.ID, label, shape)
+	}
+	for _, edge := range g.Edges {
+		attributes := fmt.Sprintf("weight=%d", edge.Weight)
+		if edge.Weight > 1 {
+			attributes += fmt.Sprintf(", label=\"%d\", penwidth=%d", edge.Weight, min(edge.Weight, 5))
+		}
+		fmt.Fprintf(&b, "  %q -- %q [%s];\n", edge.From, edge.To, attributes)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteGraphML exports the graph as GraphML with label, type, tmdb_id, rating and year
+// node attributes and a weight edge attribute
+func WriteGraphML(w io.Writer, g *Graph) error {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">` + "\n")
+	b.WriteString(`  <key id="label" for="node" attr.name="label" attr.type="string"/>` + "\n")
+	b.WriteString(`  <key id="type" for="node" attr.name="type" attr.type="string"/>` + "\n")
+	b.WriteString(`  <key id="tmdb_id" for="node" attr.name="tmdb_id" attr.type="int"/>` + "\n")
+	b.WriteString(`  <key id="rating" for="node" attr.name="rating" attr.type="double"/>` + "\n")
+	b.WriteString(`  <key id="year" for="node" attr.name="year" attr.type="string"/>` + "\n")
+	b.WriteString(`  <key id="weight" for="edge" attr.name="weight" attr.type="int"/>` + "\n")
+	b.WriteString(`  <graph id="tmdb" edgedefault="undirected">` + "\n")
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&b, "    <node id=\"%s\">\n", escapeXML(node.ID))
+		fmt.Fprintf(&b, "      <data key=\"label\">%s</data>\n", escapeXML(node.Label))
+		fmt.Fprintf(&b, "      <data key=\"type\">%s</data>\n", node.Kind)
+		fmt.Fprintf(&b, "      <data key=\"tmdb_id\">%d</data>\n", node.TmdbID)
+		if node.Kind == KindMovie {
+			fmt.Fprintf(&b, "      <data key=\"rating\">%.1f</data>\n", node.Rating)
+			if node.Year != "" {
+				fmt.Fprintf(&b, "      <data key=\"year\">%s</data>\n", node.Year)
+			}
+		}
+		b.WriteString("    </node>\n")
+	}
+	for i, edge := range g.Edges {
+		fmt.Fprintf(&b, "    <edge id=\"e%d\" source=\"%s\" target=\"%s\">\n", i, escapeXML(edge.From), escapeXML(edge.To))
+		fmt.Fprintf(&b, "      <data key=\"weight\">%d</data>\n", edge.Weight)
+		b.WriteString("    </edge>\n")
+	}
+	b.WriteString("  </graph>\n</graphml>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+type jsonNode struct {
+	ID     string  `json:"id"`
+	Type   string  `json:"type"`
+	Label  string  `json:"label"`
+	TmdbID int     `json:"tmdb_id"`
+	Rating float64 `json:"rating,omitempty"`
+	Year   string  `json:"year,omitempty"`
+}
+
+type jsonEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Weight int    `json:"weight"`
+}
+
+type jsonGraph struct {
+	Nodes     []jsonNode `json:"nodes"`
+	Edges     []jsonEdge `json:"edges"`
+	Truncated bool       `json:"truncated,omitempty"`
+}
+
+// WriteJSON exports the graph as {"nodes": [...], "edges": [...]}, the node-link format
+// of e.g. d3 and networkx
+func WriteJSON(w io.Writer, g *Graph) error {
+	out := jsonGraph{
+		Nodes:     make([]jsonNode, len(g.Nodes)),
+		Edges:     make([]jsonEdge, len(g.Edges)),
+		Truncated: g.Truncated,
+	}
+	for i, node := range g.Nodes {
+		out.Nodes[i] = jsonNode{ID: node.ID, Type: node.Kind, Label: node.Label, TmdbID: node.TmdbID, Rating: node.Rating, Year: node.Year}
+	}
+	for i, edge := range g.Edges {
+		out.Edges[i] = jsonEdge{Source: edge.From, Target: edge.To, Weight: edge.Weight}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// ErrBudgetExceeded is returned when a search needs more requests than its budget allows
+var ErrBudgetExceeded = errors.New("request budget exceeded")
+
+// CreditsFunc fetches the movies of a person
+type CreditsFunc func(personID int) ([]models.Movie, error)
+
+// CastFunc fetches the cast of a movie
+type CastFunc func(movieID int) ([]models.CastMember, error)
+
+// Memo memoizes credit lookups and limits the number of requests made
+type Memo struct {
+	credits CreditsFunc
+	cast    CastFunc
+	// Budget is the maximum number of requests, 0 for no limit
+	Budget int
+	// Requests counts the requests made so far
+	Requests int
+
+	personCredits map[int][]models.Movie
+	movieCast     map[int][]models.CastMember
+}
+
+// NewMemo creates a Memo over the given lookups with a request budget (0 for no limit)
+func NewMemo(credits CreditsFunc, cast CastFunc, budget int) *Memo {
+	return &Memo{
+		credits:       credits,
+		cast:          cast,
+		Budget:        budget,
+		personCredits: make(map[int][]models.Movie),
+		movieCast:     make(map[int][]models.CastMember),
+	}
+}
+
+// Charge counts a request against the budget, e.g. a lookup made outside the memo
+func (m *Memo) Charge() error {
+	if m.Budget > 0 && m.Requests >= m.Budget {
+		return ErrBudgetExceeded
+	}
+	m.Requests++
+	return nil
+}
+
+// Credits returns the movies of a person, fetching them once
+func (m *Memo) Credits(personID int) ([]models.Movie, error) {
+	if credits, ok := m.personCredits[personID]; ok {
+		return credits, nil
+	}
+	if err := m.Charge(); err != nil {
+		return nil, err
+	}
+	credits, err := m.credits(personID)
+	if err != nil {
+		return nil, err
+	}
+	m.personCredits[personID] = credits
+	return credits, nil
+}
+
+// Cast returns the cast of a movie, fetching it once
+func (m *Memo) Cast(movieID int) ([]models.CastMember, error) {
+	if cast, ok := m.movieCast[movieID]; ok {
+		return cast, nil
+	}
+	if err := m.Charge(); err != nil {
+		return nil, err
+	}
+	cast, err := m.cast(movieID)
+	if err != nil {
+		return nil, err
+	}
+	m.movieCast[movieID] = cast
+	return cast, nil
+}
@@ -0,0 +1,198 @@
+package graph
+
+import (
+	"sort"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// Person is a node of the co-star graph
+type Person struct {
+	ID   int
+	Name string
+}
+
+// Path is a chain of people where Movies[i] connects People[i] and People[i+1]
+type Path struct {
+	People []Person
+	Movies []models.Movie
+}
+
+// Options limit the path search
+type Options struct {
+	// MaxDepth is the maximum number of movies in a path
+	MaxDepth int
+	// MaxMoviesPerPerson expands only the most popular movies of a person, 0 for all
+	MaxMoviesPerPerson int
+	// MaxCastPerMovie follows only the top billed cast of a movie, 0 for all
+	MaxCastPerMovie int
+	// Allow restricts the movies a path may use, e.g. to those on the desired providers
+	Allow func(models.Movie) (bool, error)
+}
+
+// link records how a person was reached: from prev via movie, depth movies away from the start
+type link struct {
+	prev  int
+	movie models.Movie
+	depth int
+}
+
+// side is one direction of the bidirectional search
+type side struct {
+	visited  map[int]link
+	frontier []int
+	depth    int
+}
+
+func newSide(start Person) *side {
+	return &side{
+		visited:  map[int]link{start.ID: {prev: -1}},
+		frontier: []int{start.ID},
+	}
+}
+
+// FindPath finds a shortest co-star path between two people with a bidirectional
+// breadth-first search, expanding the side with the smaller frontier first. It returns
+// false if no path exists within the limits, or ErrBudgetExceeded from the memo.
+func FindPath(memo *Memo, from, to Person, opts Options) (Path, bool, error) {
+	names := map[int]string{from.ID: from.Name, to.ID: to.Name}
+	if from.ID == to.ID {
+		return Path{People: []Person{from}}, true, nil
+	}
+
+	forward, backward := newSide(from), newSide(to)
+	for len(forward.frontier) > 0 && len(backward.frontier) > 0 && forward.depth+backward.depth < opts.MaxDepth {
+		this, other := forward, backward
+		if len(backward.frontier) < len(forward.frontier) {
+			this, other = backward, forward
+		}
+
+		meeting, found, err := expand(memo, this, other, names, opts)
+		if err != nil {
+			return Path{}, false, err
+		}
+		if found {
+			if this == forward {
+				return buildPath(forward, backward, meeting.from, meeting.to, meeting.movie, names), true, nil
+			}
+			return buildPath(forward, backward, meeting.to, meeting.from, meeting.movie, names), true, nil
+		}
+	}
+	return Path{}, false, nil
+}
+
+// meeting is an edge between the two sides of the search
+type meeting struct {
+	from, to int
+	movie    models.Movie
+	length   int
+}
+
+// expand replaces the frontier of this side by the next level and returns the shortest
+// connection to the other side found on the way, if any
+func expand(memo *Memo, this, other *side, names map[int]string, opts Options) (meeting, bool, error) {
+	var best meeting
+	found := false
+	var next []int
+
+	for _, personID := range this.frontier {
+		movies, err := memo.Credits(personID)
+		if err != nil {
+			return best, false, err
+		}
+
+		for _, movie := range expansionMovies(movies, opts.MaxMoviesPerPerson) {
+			if opts.Allow != nil {
+				allowed, err := opts.Allow(movie)
+				if err != nil {
+					return best, false, err
+				}
+				if !allowed {
+					continue
+				}
+			}
+
+			cast, err := memo.Cast(movie.ID)
+			if err != nil {
+				return best, false, err
+			}
+
+			for _, member := range topBilled(cast, opts.MaxCastPerMovie) {
+				if _, ok := names[member.ID]; !ok {
+					names[member.ID] = member.Name
+				}
+				if l, ok := other.visited[member.ID]; ok {
+					length := this.depth + 1 + l.depth
+					if !found || length < best.length {
+						best = meeting{from: personID, to: member.ID, movie: movie, length: length}
+						found = true
+					}
+				}
+				if _, ok := this.visited[member.ID]; ok {
+					continue
+				}
+				this.visited[member.ID] = link{prev: personID, movie: movie, depth: this.depth + 1}
+				next = append(next, member.ID)
+			}
+		}
+	}
+
+	this.frontier = next
+	this.depth++
+	return best, found, nil
+}
+
+// expansionMovies returns the released movies of a person, most popular first
+func expansionMovies(movies []models.Movie, limit int) []models.Movie {
+	released := make([]models.Movie, 0, len(movies))
+	for _, movie := range movies {
+		if movie.ReleaseDate != "" {
+			released = append(released, movie)
+		}
+	}
+	sort.SliceStable(released, func(i, j int) bool {
+		return released[i].Popularity > released[j].Popularity
+	})
+	if limit > 0 && len(released) > limit {
+		released = released[:limit]
+	}
+	return released
+}
+
+// topBilled returns the first limit cast members by billing order
+func topBilled(cast []models.CastMember, limit int) []models.CastMember {
+	sorted := make([]models.CastMember, len(cast))
+	copy(sorted, cast)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Order < sorted[j].Order
+	})
+	if limit > 0 && len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+	return sorted
+}
+
+// buildPath joins the forward chain ending in a and the backward chain starting at b via movie
+func buildPath(forward, backward *side, a, b int, movie models.Movie, names map[int]string) Path {
+	var path Path
+
+	// Walk back from a to the start and reverse
+	for id := a; id != -1; id = forward.visited[id].prev {
+		path.People = append([]Person{{ID: id, Name: names[id]}}, path.People...)
+		if l := forward.visited[id]; l.prev != -1 {
+			path.Movies = append([]models.Movie{l.movie}, path.Movies...)
+		}
+	}
+
+	path.Movies = append(path.Movies, movie)
+
+	// Walk forward from b to the target
+	for id := b; id != -1; id = backward.visited[id].prev {
+		path.People = append(path.People, Person{ID: id, Name: names[id]})
+		if l := backward.visited[id]; l.prev != -1 {
+			path.Movies = append(path.Movies, l.movie)
+		}
+	}
+
+	return path
+}
@@ -1,11 +1,15 @@
 package models
 
+import "time"
+
 type Actor struct {
 	ID          int     `json:"id"`
 	Name        string  `json:"name"`
 	KnownFor    []Movie `json:"known_for"`
 	Popularity  float64 `json:"popularity"`
 	ProfilePath string  `json:"profile_path"`
+	// KnownForDepartment is e.g. "Acting" or "Directing"
+	KnownForDepartment string `json:"known_for_department"`
 }
 
 type ActorSearchResponse struct {
@@ -15,7 +19,47 @@ type ActorSearchResponse struct {
 	TotalResults int     `json:"total_results"`
 }
 
+// ActorCreditsResponse holds movie credits or, for combined credits, movie and tv
+// credits told apart by MediaType
 type ActorCreditsResponse struct {
 	ID   int     `json:"id"`
 	Cast []Movie `json:"cast"`
+	Crew []Movie `json:"crew"`
+}
+
+// PersonDetails is the /person/{id} response
+type PersonDetails struct {
+	ID                 int     `json:"id"`
+	Name               string  `json:"name"`
+	Birthday           string  `json:"birthday"`
+	Deathday           string  `json:"deathday"`
+	PlaceOfBirth       string  `json:"place_of_birth"`
+	Biography          string  `json:"biography"`
+	KnownForDepartment string  `json:"known_for_department"`
+	Popularity         float64 `json:"popularity"`
+	ProfilePath        string  `json:"profile_path"`
+}
+
+// Age returns the age at now or at the deathday, false if the birthday is unknown
+func (p *PersonDetails) Age(now time.Time) (int, bool) {
+	birthday, err := time.Parse("2006-01-02", p.Birthday)
+	if err != nil {
+		return 0, false
+	}
+	if deathday, err := time.Parse("2006-01-02", p.Deathday); err == nil {
+		now = deathday
+	}
+	age := now.Year() - birthday.Year()
+	if now.Month() < birthday.Month() || (now.Month() == birthday.Month() && now.Day() < birthday.Day()) {
+		age--
+	}
+	return age, true
+}
+
+// ProfileImageURL returns the URL of a profile image in the given size (e.g. "w185"), or "" without one
+func ProfileImageURL(path, size string) string {
+	if path == "" {
+		return ""
+	}
+	return "https://image.tmdb.org/t/p/" + size + path
 }
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// certificationAges maps the certifications of a region to the minimum age they
+// stand for, so that a --max-certification can be compared across rating systems.
+// Regions not listed here use age-based certifications (e.g. "12" or "16+").
+var certificationAges = map[string]map[string]int{
+	// FSK and FSK-based TV ratings
+	"DE": {"0": 0, "6": 6, "12": 12, "16": 16, "18": 18},
+	// MPAA and TV Parental Guidelines
+	"US": {
+		"G": 0, "PG": 10, "PG-13": 13, "R": 17, "NC-17": 18,
+		"TV-Y": 0, "TV-Y7": 7, "TV-G": 0, "TV-PG": 10, "TV-14": 14, "TV-MA": 17,
+	},
+	// BBFC
+	"GB": {"U": 0, "PG": 8, "12A": 12, "12": 12, "15": 15, "18": 18, "R18": 18},
+	// CNC
+	"FR": {"U": 0, "TP": 0, "10": 10, "12": 12, "16": 16, "18": 18},
+}
+
+// CertificationAge returns the minimum age a certification of the given region stands for
+func CertificationAge(region, certification string) (int, bool) {
+	certification = strings.ToUpper(strings.TrimSpace(certification))
+	if certification == "" {
+		return 0, false
+	}
+
+	if ages, ok := certificationAges[strings.ToUpper(region)]; ok {
+		if age, ok := ages[certification]; ok {
+			return age, true
+		}
+	}
+
+	// Fall back to the leading number, e.g. "FSK 12", "16+" or "K-15"
+	digits := strings.TrimLeftFunc(certification, func(r rune) bool { return !unicode.IsDigit(r) })
+	digits = strings.TrimRightFunc(digits, func(r rune) bool { return !unicode.IsDigit(r) })
+	age, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, false
+	}
+	return age, true
+}
+
+// IsCertification reports whether the label is a known certification of the region
+func IsCertification(region, certification string) bool {
+	ages, ok := certificationAges[strings.ToUpper(region)]
+	if !ok {
+		return false
+	}
+	_, ok = ages[strings.ToUpper(strings.TrimSpace(certification))]
+	return ok
+}
+
+// Certification returns the certification of a movie in a region, preferring the
+// theatrical release. It returns an empty string if the region has none.
+func (r *ReleaseDatesResponse) Certification(region string) string {
+	certification := ""
+	for _, date := range r.ForRegion(region) {
+		if date.Certification == "" {
+			continue
+		}
+		if date.Type == ReleaseTypeTheatrical {
+			return date.Certification
+		}
+		if certification == "" {
+			certification = date.Certification
+		}
+	}
+	return certification
+}
+
+type ContentRating struct {
+	CountryCode string `json:"iso_3166_1"`
+	Rating      string `json:"rating"`
+}
+
+type ContentRatingsResponse struct {
+	ID      int             `json:"id"`
+	Results []ContentRating `json:"results"`
+}
+
+// Certification returns the content rating of a show in a region, or an empty string
+func (r *ContentRatingsResponse) Certification(region string) string {
+	for _, rating := range r.Results {
+		if rating.CountryCode == strings.ToUpper(region) {
+			return rating.Rating
+		}
+	}
+	return ""
+}
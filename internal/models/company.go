@@ -0,0 +1,62 @@
+package models
+
+import "strings"
+
+type Company struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	OriginCountry string `json:"origin_country"`
+}
+
+type CompanySearchResponse struct {
+	Page         int       `json:"page"`
+	Results      []Company `json:"results"`
+	TotalPages   int       `json:"total_pages"`
+	TotalResults int       `json:"total_results"`
+}
+
+type Network struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	OriginCountry string `json:"origin_country"`
+}
+
+// knownNetworks maps the names of popular TV networks to their TMDB IDs,
+// since TMDB has no search endpoint for networks
+var knownNetworks = map[string]int{
+	"abc":         2,
+	"amazon":      1024,
+	"amc":         174,
+	"apple tv+":   2552,
+	"bbc one":     4,
+	"cbs":         16,
+	"disney+":     2739,
+	"fox":         19,
+	"fx":          88,
+	"hbo":         49,
+	"hulu":        453,
+	"max":         3186,
+	"nbc":         6,
+	"netflix":     213,
+	"paramount+":  4330,
+	"peacock":     3353,
+	"prime video": 1024,
+	"showtime":    67,
+	"starz":       318,
+	"the cw":      71,
+}
+
+// KnownNetworkID returns the TMDB ID of a well-known network by name
+func KnownNetworkID(name string) (int, bool) {
+	id, ok := knownNetworks[strings.ToLower(strings.TrimSpace(name))]
+	return id, ok
+}
+
+// KnownNetworkNames returns the names accepted by KnownNetworkID
+func KnownNetworkNames() []string {
+	names := make([]string, 0, len(knownNetworks))
+	for name := range knownNetworks {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,18 @@
+package models
+
+// CastMember is a person in the cast or crew of a movie
+type CastMember struct {
+	ID         int     `json:"id"`
+	Name       string  `json:"name"`
+	Character  string  `json:"character"`
+	Job        string  `json:"job"`
+	Order      int     `json:"order"`
+	Popularity float64 `json:"popularity"`
+}
+
+// MovieCreditsResponse is the /movie/{id}/credits response
+type MovieCreditsResponse struct {
+	ID   int          `json:"id"`
+	Cast []CastMember `json:"cast"`
+	Crew []CastMember `json:"crew"`
+}
@@ -0,0 +1,34 @@
+package models
+
+type Keyword struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type KeywordSearchResponse struct {
+	Page         int       `json:"page"`
+	Results      []Keyword `json:"results"`
+	TotalPages   int       `json:"total_pages"`
+	TotalResults int       `json:"total_results"`
+}
+
+// MovieKeywordsResponse is returned by /movie/{id}/keywords
+type MovieKeywordsResponse struct {
+	ID       int       `json:"id"`
+	Keywords []Keyword `json:"keywords"`
+}
+
+// ShowKeywordsResponse is returned by /tv/{id}/keywords, which uses "results" instead of "keywords"
+type ShowKeywordsResponse struct {
+	ID      int       `json:"id"`
+	Results []Keyword `json:"results"`
+}
+
+// KeywordIDs returns the IDs of the given keywords
+func KeywordIDs(keywords []Keyword) []int {
+	ids := make([]int, len(keywords))
+	for i, keyword := range keywords {
+		ids[i] = keyword.ID
+	}
+	return ids
+}
@@ -0,0 +1,59 @@
+package models
+
+// languageNames maps ISO 639-1 codes to English names for the languages most common on TMDB
+var languageNames = map[string]string{
+	"ar": "Arabic",
+	"cn": "Cantonese",
+	"cs": "Czech",
+	"da": "Danish",
+	"de": "German",
+	"el": "Greek",
+	"en": "English",
+	"es": "Spanish",
+	"fa": "Persian",
+	"fi": "Finnish",
+	"fr": "French",
+	"he": "Hebrew",
+	"hi": "Hindi",
+	"hu": "Hungarian",
+	"id": "Indonesian",
+	"is": "Icelandic",
+	"it": "Italian",
+	"ja": "Japanese",
+	"ko": "Korean",
+	"ml": "Malayalam",
+	"nl": "Dutch",
+	"no": "Norwegian",
+	"pl": "Polish",
+	"pt": "Portuguese",
+	"ro": "Romanian",
+	"ru": "Russian",
+	"sv": "Swedish",
+	"ta": "Tamil",
+	"te": "Telugu",
+	"th": "Thai",
+	"tl": "Tagalog",
+	"tr": "Turkish",
+	"uk": "Ukrainian",
+	"zh": "Chinese",
+}
+
+// LanguageName returns the English name of an ISO 639-1 code, or the code itself if unknown
+func LanguageName(code string) string {
+	if name, ok := languageNames[code]; ok {
+		return name
+	}
+	return code
+}
+
+// FormatLanguage renders a language code as "Korean (ko)"
+func FormatLanguage(code string) string {
+	if code == "" {
+		return ""
+	}
+	name := LanguageName(code)
+	if name == code {
+		return code
+	}
+	return name + " (" + code + ")"
+}
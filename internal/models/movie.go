@@ -1,18 +1,41 @@
 package models
 
+import "fmt"
+
 type Movie struct {
-	ID            int     `json:"id"`
-	Title         string  `json:"title"`
-	Name          string  `json:"name"`
-	OriginalTitle string  `json:"original_title"`
-	Overview      string  `json:"overview"`
-	ReleaseDate   string  `json:"release_date"`
-	FirstAirDate  string  `json:"first_air_date"`
-	VoteAverage   float64 `json:"vote_average"`
-	VoteCount     int     `json:"vote_count"`
-	GenreIDs      []int   `json:"genre_ids"`
-	Genres        []Genre `json:"genres"`
-	Character     string  `json:"character"`
+	ID            int    `json:"id"`
+	Title         string `json:"title"`
+	Name          string `json:"name"`
+	OriginalTitle string `json:"original_title"`
+	// OriginalName and MediaType are only set for tv entries of combined credits
+	OriginalName string `json:"original_name"`
+	MediaType    string `json:"media_type"`
+	// OriginalLanguage is an ISO 639-1 code, e.g. "ko"
+	OriginalLanguage string  `json:"original_language"`
+	Overview         string  `json:"overview"`
+	ReleaseDate      string  `json:"release_date"`
+	FirstAirDate     string  `json:"first_air_date"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int     `json:"vote_count"`
+	Popularity       float64 `json:"popularity"`
+	GenreIDs         []int   `json:"genre_ids"`
+	Genres           []Genre `json:"genres"`
+	Character        string  `json:"character"`
+	// Job and Department are only set for crew credits, e.g. "Director" in "Directing"
+	Job        string `json:"job"`
+	Department string `json:"department"`
+	// EpisodeCount is the number of episodes of a tv credit
+	EpisodeCount int `json:"episode_count"`
+	// Runtime in minutes, only set by the details endpoint
+	Runtime int `json:"runtime"`
+	// OriginCountry holds ISO 3166-1 codes, only set by the details endpoint
+	OriginCountry []string `json:"origin_country"`
+	// ProductionCompanies is only set by the details endpoint
+	ProductionCompanies []Company `json:"production_companies"`
+	// Certification is the age rating in the watch region, set when the certification filter is active
+	Certification string `json:"-"`
+	// KeywordIDs are set when the keyword filter is active, nil if not fetched yet
+	KeywordIDs []int `json:"-"`
 }
 
 type DiscoverResponse struct {
@@ -49,6 +72,36 @@ func (m *Movie) GetTitle() string {
 	return m.Name
 }
 
+// IsShow reports whether the entry is a tv credit of a combined filmography
+func (m *Movie) IsShow() bool {
+	return m.MediaType == MediaTypeTV
+}
+
+// GetMediaType returns the media type, "movie" for entries of movie-only lists
+func (m *Movie) GetMediaType() string {
+	if m.MediaType == "" {
+		return MediaTypeMovie
+	}
+	return m.MediaType
+}
+
+// ToShow converts a tv credit into a Show
+func (m *Movie) ToShow() Show {
+	return Show{
+		ID:               m.ID,
+		Name:             m.GetTitle(),
+		OriginalName:     m.OriginalName,
+		Overview:         m.Overview,
+		FirstAirDate:     m.FirstAirDate,
+		VoteAverage:      m.VoteAverage,
+		VoteCount:        m.VoteCount,
+		Popularity:       m.Popularity,
+		OriginalLanguage: m.OriginalLanguage,
+		OriginCountry:    m.OriginCountry,
+		GenreIDs:         m.GenreIDs,
+	}
+}
+
 func (m *Movie) GetGenreNames() []string {
 	names := make([]string, len(m.Genres))
 	for i, genre := range m.Genres {
@@ -56,3 +109,14 @@ func (m *Movie) GetGenreNames() []string {
 	}
 	return names
 }
+
+// FormatRuntime renders a runtime in minutes as "2h 10m"
+func FormatRuntime(minutes int) string {
+	if minutes <= 0 {
+		return ""
+	}
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+}
@@ -1,9 +1,15 @@
 package models
 
 type Provider struct {
+	ProviderID   int    `json:"provider_id"`
 	ProviderName string `json:"provider_name"`
 }
 
+// ProviderListResponse lists the watch providers available in a region
+type ProviderListResponse struct {
+	Results []Provider `json:"results"`
+}
+
 type RegionProviders struct {
 	Link     string     `json:"link"`
 	Flatrate []Provider `json:"flatrate"`
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"sort"
+	"strings"
+)
+
+// TMDB release types as returned by /movie/{id}/release_dates
+const (
+	ReleaseTypePremiere          = 1
+	ReleaseTypeTheatricalLimited = 2
+	ReleaseTypeTheatrical        = 3
+	ReleaseTypeDigital           = 4
+	ReleaseTypePhysical          = 5
+	ReleaseTypeTV                = 6
+)
+
+type ReleaseDate struct {
+	Certification string `json:"certification"`
+	Language      string `json:"iso_639_1"`
+	Note          string `json:"note"`
+	ReleaseDate   string `json:"release_date"`
+	Type          int    `json:"type"`
+}
+
+type CountryReleaseDates struct {
+	CountryCode  string        `json:"iso_3166_1"`
+	ReleaseDates []ReleaseDate `json:"release_dates"`
+}
+
+type ReleaseDatesResponse struct {
+	ID      int                   `json:"id"`
+	Results []CountryReleaseDates `json:"results"`
+}
+
+// ForRegion returns the release dates of a region (case-insensitive, e.g. "de") sorted by date
+func (r *ReleaseDatesResponse) ForRegion(region string) []ReleaseDate {
+	for _, country := range r.Results {
+		if !strings.EqualFold(country.CountryCode, region) {
+			continue
+		}
+		dates := make([]ReleaseDate, len(country.ReleaseDates))
+		copy(dates, country.ReleaseDates)
+		sort.SliceStable(dates, func(i, j int) bool {
+			return dates[i].ReleaseDate < dates[j].ReleaseDate
+		})
+		return dates
+	}
+	return nil
+}
+
+// GetDate returns the release date without the time component (YYYY-MM-DD)
+func (d ReleaseDate) GetDate() string {
+	if len(d.ReleaseDate) >= 10 {
+		return d.ReleaseDate[:10]
+	}
+	return d.ReleaseDate
+}
+
+// GetTypeName returns a human readable name for the release type
+func (d ReleaseDate) GetTypeName() string {
+	switch d.Type {
+	case ReleaseTypePremiere:
+		return "Premiere"
+	case ReleaseTypeTheatricalLimited:
+		return "Theatrical (limited)"
+	case ReleaseTypeTheatrical:
+		return "Theatrical"
+	case ReleaseTypeDigital:
+		return "Digital"
+	case ReleaseTypePhysical:
+		return "Physical"
+	case ReleaseTypeTV:
+		return "TV"
+	}
+	return "Unknown"
+}
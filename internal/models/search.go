@@ -0,0 +1,108 @@
+package models
+
+// Media types returned by /search/multi
+const (
+	MediaTypeMovie  = "movie"
+	MediaTypeTV     = "tv"
+	MediaTypePerson = "person"
+)
+
+// MultiSearchResult is a single entry of /search/multi. Depending on MediaType,
+// only the movie, show or person fields are populated.
+type MultiSearchResult struct {
+	ID                 int      `json:"id"`
+	MediaType          string   `json:"media_type"`
+	Title              string   `json:"title"`
+	Name               string   `json:"name"`
+	OriginalTitle      string   `json:"original_title"`
+	OriginalName       string   `json:"original_name"`
+	OriginalLanguage   string   `json:"original_language"`
+	OriginCountry      []string `json:"origin_country"`
+	Overview           string   `json:"overview"`
+	ReleaseDate        string   `json:"release_date"`
+	FirstAirDate       string   `json:"first_air_date"`
+	VoteAverage        float64  `json:"vote_average"`
+	VoteCount          int      `json:"vote_count"`
+	Popularity         float64  `json:"popularity"`
+	GenreIDs           []int    `json:"genre_ids"`
+	ProfilePath        string   `json:"profile_path"`
+	KnownForDepartment string   `json:"known_for_department"`
+	KnownFor           []Movie  `json:"known_for"`
+}
+
+type MultiSearchResponse struct {
+	Page         int                 `json:"page"`
+	Results      []MultiSearchResult `json:"results"`
+	TotalPages   int                 `json:"total_pages"`
+	TotalResults int                 `json:"total_results"`
+}
+
+// GetTitle returns the title of a movie or the name of a show or person
+func (r *MultiSearchResult) GetTitle() string {
+	if r.Title != "" {
+		return r.Title
+	}
+	return r.Name
+}
+
+// GetDate returns the release date of a movie or the first air date of a show
+func (r *MultiSearchResult) GetDate() string {
+	if r.ReleaseDate != "" {
+		return r.ReleaseDate
+	}
+	return r.FirstAirDate
+}
+
+func (r *MultiSearchResult) GetYear() string {
+	date := r.GetDate()
+	if len(date) >= 4 {
+		return "(" + date[:4] + ")"
+	}
+	return ""
+}
+
+// ToMovie converts a movie result into a Movie
+func (r *MultiSearchResult) ToMovie() Movie {
+	return Movie{
+		ID:               r.ID,
+		Title:            r.Title,
+		OriginalTitle:    r.OriginalTitle,
+		OriginalLanguage: r.OriginalLanguage,
+		Overview:         r.Overview,
+		ReleaseDate:      r.ReleaseDate,
+		VoteAverage:      r.VoteAverage,
+		VoteCount:        r.VoteCount,
+		Popularity:       r.Popularity,
+		GenreIDs:         r.GenreIDs,
+	}
+}
+
+// ToShow converts a tv result into a Show
+func (r *MultiSearchResult) ToShow() Show {
+	return Show{
+		ID:               r.ID,
+		Name:             r.Name,
+		OriginalName:     r.OriginalName,
+		Overview:         r.Overview,
+		FirstAirDate:     r.FirstAirDate,
+		VoteAverage:      r.VoteAverage,
+		VoteCount:        r.VoteCount,
+		Popularity:       r.Popularity,
+		OriginalLanguage: r.OriginalLanguage,
+		OriginCountry:    r.OriginCountry,
+		GenreIDs:         r.GenreIDs,
+	}
+}
+
+// ToActor converts a person result into an Actor
+func (r *MultiSearchResult) ToActor() Actor {
+	return Actor{
+		ID:          r.ID,
+		Name:        r.Name,
+		KnownFor:    r.KnownFor,
+		Popularity:  r.Popularity,
+		ProfilePath: r.ProfilePath,
+
+		KnownForDepartment: r.KnownForDepartment,
+	}
+}
@@ -1,14 +1,31 @@
 package models
 
+import (
+	"fmt"
+	"time"
+)
+
 type Show struct {
-	ID             int     `json:"id"`
-	Name           string  `json:"name"`
-	OriginalName   string  `json:"original_name"`
-	Overview       string  `json:"overview"`
-	FirstAirDate   string  `json:"first_air_date"`
-	VoteAverage    float64 `json:"vote_average"`
-	VoteCount      int     `json:"vote_count"`
-	OriginalLanguage string `json:"original_language"`
+	ID               int      `json:"id"`
+	Name             string   `json:"name"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	FirstAirDate     string   `json:"first_air_date"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int      `json:"vote_count"`
+	Popularity       float64  `json:"popularity"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginCountry    []string `json:"origin_country"`
+	GenreIDs         []int    `json:"genre_ids"`
+	// EpisodeRunTime is only set by the details endpoint
+	EpisodeRunTime []int `json:"episode_run_time"`
+	// Certification is the content rating in the watch region, set when the certification filter is active
+	Certification string `json:"-"`
+	// KeywordIDs are set when the keyword filter is active, nil if not fetched yet
+	KeywordIDs []int `json:"-"`
+	// Networks and ProductionCompanies are copied from the details when filtering by them
+	Networks            []Network `json:"-"`
+	ProductionCompanies []Company `json:"-"`
 }
 
 type ShowDiscoverResponse struct {
@@ -19,7 +36,22 @@ type ShowDiscoverResponse struct {
 }
 
 type ShowDetails struct {
-	Name string `json:"name"`
+	Name                string    `json:"name"`
+	NumberOfSeasons     int       `json:"number_of_seasons"`
+	NumberOfEpisodes    int       `json:"number_of_episodes"`
+	NextEpisodeToAir    *Episode  `json:"next_episode_to_air"`
+	LastEpisodeToAir    *Episode  `json:"last_episode_to_air"`
+	EpisodeRunTime      []int     `json:"episode_run_time"`
+	Networks            []Network `json:"networks"`
+	ProductionCompanies []Company `json:"production_companies"`
+}
+
+type Episode struct {
+	Name          string `json:"name"`
+	AirDate       string `json:"air_date"`
+	EpisodeNumber int    `json:"episode_number"`
+	SeasonNumber  int    `json:"season_number"`
+	Runtime       int    `json:"runtime"`
 }
 
 type ShowExternalIDs struct {
@@ -42,4 +74,57 @@ func (s *Show) GetYear() string {
 
 func (s *Show) GetTitle() string {
 	return s.Name
-}
\ No newline at end of file
+}
+
+// GetEpisodeRuntime returns the typical episode runtime in minutes or 0 if unknown
+func (s *Show) GetEpisodeRuntime() int {
+	for _, runtime := range s.EpisodeRunTime {
+		if runtime > 0 {
+			return runtime
+		}
+	}
+	return 0
+}
+
+// GetEpisodeRuntime returns the typical episode runtime in minutes, falling back
+// to the runtime of the latest episode since TMDB often leaves episode_run_time empty
+func (d *ShowDetails) GetEpisodeRuntime() int {
+	for _, runtime := range d.EpisodeRunTime {
+		if runtime > 0 {
+			return runtime
+		}
+	}
+	if d.LastEpisodeToAir != nil {
+		return d.LastEpisodeToAir.Runtime
+	}
+	return 0
+}
+
+// GetCode returns the episode number in the common S01E02 notation
+func (e *Episode) GetCode() string {
+	return fmt.Sprintf("S%02dE%02d", e.SeasonNumber, e.EpisodeNumber)
+}
+
+// AirsBetween reports whether the episode air date lies within [from, to]
+func (e *Episode) AirsBetween(from, to time.Time) bool {
+	airDate, err := time.Parse("2006-01-02", e.AirDate)
+	if err != nil {
+		return false
+	}
+	return !airDate.Before(from) && !airDate.After(to)
+}
+
+// HasSeasonPremiereWithin reports whether a season starts (episode 1 airs)
+// between today and the given number of days ahead. Both the last and next episode
+// are checked, so a premiere that aired earlier today is found as well.
+func (d *ShowDetails) HasSeasonPremiereWithin(now time.Time, days int) bool {
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, days)
+
+	for _, episode := range []*Episode{d.LastEpisodeToAir, d.NextEpisodeToAir} {
+		if episode != nil && episode.EpisodeNumber == 1 && episode.AirsBetween(from, to) {
+			return true
+		}
+	}
+	return false
+}
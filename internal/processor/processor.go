@@ -2,9 +2,11 @@ package processor
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/sebastianneubert/tmdb/internal/api"
 	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/expr"
 	"github.com/sebastianneubert/tmdb/internal/filters"
 	"github.com/sebastianneubert/tmdb/internal/models"
 )
@@ -14,16 +16,159 @@ type FilterConfig struct {
 	MinRating        float64
 	MinVotes         int
 	Region           string
-	GenreFilter      string
+	Genres           filters.GenreFilter
 	DesiredProviders map[string]bool
 	GenreList        []models.Genre
 	GenreMap         map[string]int
+	// EnglishGenreList names the genres in --where expressions, which are written in
+	// English; GenreList is used when it is empty
+	EnglishGenreList []models.Genre
+	// SkipProviderFilter keeps movies that are not (yet) streaming on the desired providers
+	SkipProviderFilter bool
+	// DateRange restricts the release date (movies) or first air date (shows)
+	DateRange filters.DateRange
+	// MinRuntime and MaxRuntime bound the runtime in minutes (episode runtime for shows), 0 = no bound
+	MinRuntime int
+	MaxRuntime int
+	// FitsBudget is the time budget in minutes for the --fits selection, 0 = disabled
+	FitsBudget int
+	// Keywords matches titles with any included and none of the excluded keywords
+	Keywords filters.KeywordFilter
+	// Companies matches titles by any of these production companies, Networks shows
+	// aired on any of these networks
+	Companies []models.Company
+	Networks  []models.Network
+	// OriginalLanguages (ISO 639-1) and OriginCountries (ISO 3166-1) match any of the codes
+	OriginalLanguages []string
+	OriginCountries   []string
+	// MaxCertification is the --max-certification value as given, empty = no age rating
+	// filter; MaxCertificationAge is the age it stands for in the region
+	MaxCertification    string
+	MaxCertificationAge int
+	// Sort is the --sort key for the displayed results, empty = API order
+	Sort string
+	// CollectAll keeps searching movies after MaxResultsToDisplay matches (up to
+	// MaxPagesToSearch pages), for results that are ranked (--fits, --sort) before being
+	// displayed. Shows are always displayed right away.
+	CollectAll bool
+	// Scoring is the rating mode and the prior used for weighted ratings and hidden-gem scores
+	Scoring filters.Scoring
+	// Where is an optional filter expression, evaluated after the provider check
+	Where *expr.Expression
+	// Seen hides the titles of the seen history, unless --include-seen is given
+	Seen filters.SeenFilter
+}
+
+// NeedsRuntime reports whether runtimes have to be fetched, since list results don't include them
+func (fc FilterConfig) NeedsRuntime() bool {
+	return fc.MinRuntime > 0 || fc.MaxRuntime > 0 || fc.FitsBudget > 0 || (fc.Where != nil && fc.Where.Uses("runtime"))
+}
+
+// NeedsDiscover reports whether filters are set that the regular list endpoints
+// can't apply, so commands should fetch from discover instead
+func (fc FilterConfig) NeedsDiscover() bool {
+	return !fc.DateRange.IsZero() || fc.MinRuntime > 0 || fc.MaxRuntime > 0 ||
+		len(fc.OriginalLanguages) > 0 || len(fc.OriginCountries) > 0 || !fc.Keywords.IsZero() ||
+		len(fc.Companies) > 0 || len(fc.Networks) > 0
+}
+
+// needsOriginCountry reports whether movie origin countries have to be fetched
+func (fc FilterConfig) needsOriginCountry() bool {
+	return len(fc.OriginCountries) > 0 || (fc.Where != nil && fc.Where.Uses("countries"))
+}
+
+// Describe lists the active optional filters for status messages
+func (fc FilterConfig) Describe() []string {
+	descriptions := []string{}
+	if !fc.Genres.IsZero() {
+		descriptions = append(descriptions, "Genres: "+fc.Genres.String())
+	}
+	if !fc.DateRange.IsZero() {
+		descriptions = append(descriptions, "Released: "+fc.DateRange.String())
+	}
+	if fc.MinRuntime > 0 {
+		descriptions = append(descriptions, fmt.Sprintf("Runtime >= %d min", fc.MinRuntime))
+	}
+	if fc.MaxRuntime > 0 {
+		descriptions = append(descriptions, fmt.Sprintf("Runtime <= %d min", fc.MaxRuntime))
+	}
+	if fc.FitsBudget > 0 {
+		descriptions = append(descriptions, "Fits in: "+models.FormatRuntime(fc.FitsBudget))
+	}
+	if !fc.Keywords.IsZero() {
+		descriptions = append(descriptions, "Keywords: "+fc.Keywords.String())
+	}
+	if len(fc.Companies) > 0 {
+		descriptions = append(descriptions, "Company: "+filters.CompanyNames(fc.Companies))
+	}
+	if len(fc.Networks) > 0 {
+		descriptions = append(descriptions, "Network: "+filters.NetworkNames(fc.Networks))
+	}
+	if len(fc.OriginalLanguages) > 0 {
+		descriptions = append(descriptions, "Original language: "+strings.Join(fc.OriginalLanguages, ", "))
+	}
+	if len(fc.OriginCountries) > 0 {
+		descriptions = append(descriptions, "Origin country: "+strings.Join(fc.OriginCountries, ", "))
+	}
+	if fc.MaxCertification != "" {
+		descriptions = append(descriptions, fmt.Sprintf("Certification: up to %s (%s)", fc.MaxCertification, strings.ToUpper(fc.Region)))
+	}
+	if fc.Scoring.Weighted {
+		descriptions = append(descriptions, fmt.Sprintf("Scoring: weighted (prior %.1f, %d votes)", fc.Scoring.PriorMean, fc.Scoring.PriorVotes))
+	}
+	if fc.Sort != "" {
+		descriptions = append(descriptions, "Sorted by: "+fc.Sort)
+	}
+	if fc.Where != nil {
+		descriptions = append(descriptions, "Where: "+fc.Where.String())
+	}
+	if !fc.Seen.IsZero() {
+		descriptions = append(descriptions, fmt.Sprintf("Hiding %d seen titles", fc.Seen.Len()))
+	}
+	return descriptions
+}
+
+// DiscoverParams returns the filters that can be pushed down to the discover endpoints
+func (fc FilterConfig) DiscoverParams(sortBy string) api.DiscoverParams {
+	// In weighted mode the vote count is part of the score, not a cutoff
+	minVotes := fc.MinVotes
+	if fc.Scoring.Weighted {
+		minVotes = 0
+	}
+
+	params := api.DiscoverParams{
+		SortBy:            sortBy,
+		Region:            fc.Region,
+		MinVotes:          minVotes,
+		DateFrom:          fc.DateRange.FromString(),
+		DateTo:            fc.DateRange.ToString(),
+		RuntimeMin:        fc.MinRuntime,
+		RuntimeMax:        fc.MaxRuntime,
+		WithGenres:        fc.Genres.WithGenres(),
+		WithoutGenres:     fc.Genres.WithoutGenres(),
+		WithKeywords:      fc.Keywords.WithKeywords(),
+		WithoutKeywords:   fc.Keywords.WithoutKeywords(),
+		WithCompanies:     filters.CompanyIDs(fc.Companies),
+		WithNetworks:      filters.NetworkIDs(fc.Networks),
+		OriginalLanguages: fc.OriginalLanguages,
+		OriginCountries:   fc.OriginCountries,
+	}
+	// Only certifications of the region's rating system can be pushed down, ages are checked locally
+	if models.IsCertification(fc.Region, fc.MaxCertification) {
+		params.CertificationCountry = strings.ToUpper(fc.Region)
+		params.MaxCertification = strings.ToUpper(fc.MaxCertification)
+	}
+	return params
 }
 
 // MovieProcessor handles fetching, filtering, and processing movies
 type MovieProcessor struct {
 	client *api.Client
 	config FilterConfig
+	// lookupHook is called before each lookup of MatchesMovie and MatchesShow;
+	// lookupErr is its first error, after which no more lookups are made
+	lookupHook func() error
+	lookupErr  error
 }
 
 // NewMovieProcessor creates a new MovieProcessor instance
@@ -34,6 +179,32 @@ func NewMovieProcessor(client *api.Client, config FilterConfig) *MovieProcessor
 	}
 }
 
+// SetLookupHook sets a function called before each details, certification or keyword
+// lookup, e.g. to charge a request budget. Once it fails, lookups are skipped (the
+// title doesn't match) and LookupErr returns the error.
+func (mp *MovieProcessor) SetLookupHook(hook func() error) {
+	mp.lookupHook = hook
+}
+
+// LookupErr returns the error of the lookup hook, if any
+func (mp *MovieProcessor) LookupErr() error {
+	return mp.lookupErr
+}
+
+// canLookup reports whether an extra lookup may be made
+func (mp *MovieProcessor) canLookup() bool {
+	if mp.client == nil || mp.lookupErr != nil {
+		return false
+	}
+	if mp.lookupHook != nil {
+		if err := mp.lookupHook(); err != nil {
+			mp.lookupErr = err
+			return false
+		}
+	}
+	return true
+}
+
 // ProcessMovieFunc is the callback function type for processing each movie that passes filters
 // It receives the filtered movie, available providers, and genre names
 type ProcessMovieFunc func(*models.Movie, []string, []string) error
@@ -41,13 +212,160 @@ type ProcessMovieFunc func(*models.Movie, []string, []string) error
 // FetchFunc is the callback function type for fetching a page of movies from the API
 type FetchFunc func(page int) (*models.DiscoverResponse, error)
 
+// ProcessShowFunc is the callback function type for processing each show that passes filters
+// It receives the filtered show and available providers
+type ProcessShowFunc func(*models.Show, []string) error
+
+// ShowFetchFunc is the callback function type for fetching a page of shows from the API
+type ShowFetchFunc func(page int) (*models.ShowDiscoverResponse, error)
+
+// MatchesMovie applies all filters except the provider check (rating, votes, genre,
+// release date, original language, origin country, company, runtime, certification,
+// keywords). Details, certification and keywords are fetched only when needed and
+// only after the cheap checks passed; they are stored on the movie.
+func (mp *MovieProcessor) MatchesMovie(movie *models.Movie) bool {
+	if !mp.config.Scoring.MeetsRatingCriteria(movie.VoteAverage, movie.VoteCount, mp.config.MinRating, mp.config.MinVotes) {
+		return false
+	}
+
+	if !mp.config.Genres.Matches(filters.GetGenreIDs(movie)) {
+		return false
+	}
+
+	date := movie.ReleaseDate
+	if date == "" {
+		date = movie.FirstAirDate
+	}
+	if !mp.config.DateRange.Contains(date) {
+		return false
+	}
+
+	if !filters.MatchesLanguage(movie.OriginalLanguage, mp.config.OriginalLanguages) {
+		return false
+	}
+
+	needsRuntime := mp.config.NeedsRuntime() && movie.Runtime == 0
+	needsCountry := mp.config.needsOriginCountry() && len(movie.OriginCountry) == 0
+	needsCompanies := len(mp.config.Companies) > 0 && movie.ProductionCompanies == nil
+	if (needsRuntime || needsCountry || needsCompanies) && mp.canLookup() {
+		if details, err := mp.client.GetMovieDetails(movie.ID, mp.config.Region); err == nil {
+			movie.Runtime = details.Runtime
+			movie.OriginCountry = details.OriginCountry
+			movie.ProductionCompanies = details.ProductionCompanies
+		}
+	}
+
+	if !filters.MatchesCountry(movie.OriginCountry, mp.config.OriginCountries) {
+		return false
+	}
+
+	if !filters.MatchesCompanies(movie.ProductionCompanies, mp.config.Companies) {
+		return false
+	}
+
+	if !filters.MeetsRuntimeCriteria(movie.Runtime, mp.config.MinRuntime, mp.config.MaxRuntime) {
+		return false
+	}
+
+	if mp.config.MaxCertification != "" {
+		if movie.Certification == "" && mp.canLookup() {
+			if releases, err := mp.client.GetReleaseDates(movie.ID); err == nil {
+				movie.Certification = releases.Certification(strings.ToUpper(mp.config.Region))
+			}
+		}
+		if !filters.MeetsCertification(mp.config.Region, movie.Certification, mp.config.MaxCertificationAge) {
+			return false
+		}
+	}
+
+	if !mp.config.Keywords.IsZero() {
+		if movie.KeywordIDs == nil && mp.canLookup() {
+			if keywords, err := mp.client.GetMovieKeywords(movie.ID); err == nil {
+				movie.KeywordIDs = models.KeywordIDs(keywords)
+			}
+		}
+		return mp.config.Keywords.Matches(movie.KeywordIDs)
+	}
+	return true
+}
+
+// MatchesShow applies all filters except the provider check (rating, votes, genre,
+// first air date, original language, origin country, network, company, episode runtime,
+// certification, keywords). Like MatchesMovie, the extra data is fetched only when needed.
+func (mp *MovieProcessor) MatchesShow(show *models.Show) bool {
+	if !mp.config.Scoring.MeetsRatingCriteria(show.VoteAverage, show.VoteCount, mp.config.MinRating, mp.config.MinVotes) {
+		return false
+	}
+
+	if !mp.config.Genres.Matches(show.GenreIDs) {
+		return false
+	}
+
+	if !mp.config.DateRange.Contains(show.FirstAirDate) {
+		return false
+	}
+
+	if !filters.MatchesLanguage(show.OriginalLanguage, mp.config.OriginalLanguages) {
+		return false
+	}
+
+	if !filters.MatchesCountry(show.OriginCountry, mp.config.OriginCountries) {
+		return false
+	}
+
+	needsRuntime := mp.config.NeedsRuntime() && show.GetEpisodeRuntime() == 0
+	needsNetworks := len(mp.config.Networks) > 0 && show.Networks == nil
+	needsCompanies := len(mp.config.Companies) > 0 && show.ProductionCompanies == nil
+	if (needsRuntime || needsNetworks || needsCompanies) && mp.canLookup() {
+		if details, err := mp.client.GetShowDetails(show.ID, mp.config.Region); err == nil {
+			if needsRuntime {
+				show.EpisodeRunTime = []int{details.GetEpisodeRuntime()}
+			}
+			show.Networks = details.Networks
+			show.ProductionCompanies = details.ProductionCompanies
+		}
+	}
+
+	if !filters.MatchesNetworks(show.Networks, mp.config.Networks) {
+		return false
+	}
+
+	if !filters.MatchesCompanies(show.ProductionCompanies, mp.config.Companies) {
+		return false
+	}
+	if !filters.MeetsRuntimeCriteria(show.GetEpisodeRuntime(), mp.config.MinRuntime, mp.config.MaxRuntime) {
+		return false
+	}
+
+	if mp.config.MaxCertification != "" {
+		if show.Certification == "" && mp.canLookup() {
+			if ratings, err := mp.client.GetContentRatings(show.ID); err == nil {
+				show.Certification = ratings.Certification(mp.config.Region)
+			}
+		}
+		if !filters.MeetsCertification(mp.config.Region, show.Certification, mp.config.MaxCertificationAge) {
+			return false
+		}
+	}
+
+	if !mp.config.Keywords.IsZero() {
+		if show.KeywordIDs == nil && mp.canLookup() {
+			if keywords, err := mp.client.GetShowKeywords(show.ID); err == nil {
+				show.KeywordIDs = models.KeywordIDs(keywords)
+			}
+		}
+		return mp.config.Keywords.Matches(show.KeywordIDs)
+	}
+	return true
+}
+
 // Process fetches movies page by page, applies all filters, and calls processFunc for each matching movie
 // The apiCall parameter allows different API endpoints (top-rated, popular, search, etc.)
 // The processFunc parameter allows different display/processing logic per command
 func (mp *MovieProcessor) Process(apiCall FetchFunc, processFunc ProcessMovieFunc) error {
 	resultsFound := 0
 
-	for page := 1; page <= config.MaxPagesToSearch && resultsFound < config.MaxResultsToDisplay; page++ {
+	for page := 1; page <= config.MaxPagesToSearch && !mp.limitReached(resultsFound); page++ {
 		fmt.Printf("Fetching page %d...\n", page)
 
 		resp, err := apiCall(page)
@@ -57,17 +375,16 @@ func (mp *MovieProcessor) Process(apiCall FetchFunc, processFunc ProcessMovieFun
 		}
 
 		for _, movie := range resp.Results {
-			if resultsFound >= config.MaxResultsToDisplay {
+			if mp.limitReached(resultsFound) {
 				break
 			}
 
-			// Apply rating and vote filters
-			if !filters.MeetsRatingCriteria(movie.VoteAverage, movie.VoteCount, mp.config.MinRating, mp.config.MinVotes) {
+			if mp.config.Seen.Contains(models.MediaTypeMovie, movie.ID) {
 				continue
 			}
 
-			// Apply genre filter
-			if mp.config.GenreFilter != "" && !filters.FilterByGenre(&movie, mp.config.GenreFilter, mp.config.GenreMap) {
+			// Apply rating, vote, genre and date filters
+			if !mp.MatchesMovie(&movie) {
 				continue
 			}
 
@@ -80,15 +397,19 @@ func (mp *MovieProcessor) Process(apiCall FetchFunc, processFunc ProcessMovieFun
 				isAvailable = true
 			} else {
 				providerData, err := mp.client.GetWatchProviders(movie.ID, mp.config.Region)
-				if err != nil {
+				if err != nil && !mp.config.SkipProviderFilter {
 					continue
 				}
 				availableProviders, isAvailable = filters.CheckAvailability(providerData, mp.config.DesiredProviders)
-				if !isAvailable {
+				if !isAvailable && !mp.config.SkipProviderFilter {
 					continue
 				}
 			}
 
+			if !mp.MatchesMovieExpression(&movie, availableProviders) {
+				continue
+			}
+
 			// Movie passed all filters
 			resultsFound++
 			genreNames := filters.GetGenreNames(movie.GenreIDs, mp.config.GenreList)
@@ -106,3 +427,69 @@ func (mp *MovieProcessor) Process(apiCall FetchFunc, processFunc ProcessMovieFun
 
 	return nil
 }
+
+// limitReached reports whether enough movies were found to stop searching
+func (mp *MovieProcessor) limitReached(resultsFound int) bool {
+	return !mp.config.CollectAll && resultsFound >= config.MaxResultsToDisplay
+}
+
+// ProcessShows works like Process for TV shows
+func (mp *MovieProcessor) ProcessShows(apiCall ShowFetchFunc, processFunc ProcessShowFunc) error {
+	resultsFound := 0
+
+	for page := 1; page <= config.MaxPagesToSearch && resultsFound < config.MaxResultsToDisplay; page++ {
+		fmt.Printf("Fetching page %d...\n", page)
+
+		resp, err := apiCall(page)
+		if err != nil {
+			fmt.Printf("Warning: Failed to fetch page %d: %v\n", page, err)
+			continue
+		}
+
+		for _, show := range resp.Results {
+			if resultsFound >= config.MaxResultsToDisplay {
+				break
+			}
+
+			if mp.config.Seen.Contains(models.MediaTypeTV, show.ID) {
+				continue
+			}
+
+			if !mp.MatchesShow(&show) {
+				continue
+			}
+
+			var availableProviders []string
+			var isAvailable bool
+			if mp.client == nil {
+				availableProviders = []string{}
+				isAvailable = true
+			} else {
+				providerData, err := mp.client.GetShowWatchProviders(show.ID, mp.config.Region)
+				if err != nil && !mp.config.SkipProviderFilter {
+					continue
+				}
+				availableProviders, isAvailable = filters.CheckAvailability(providerData, mp.config.DesiredProviders)
+				if !isAvailable && !mp.config.SkipProviderFilter {
+					continue
+				}
+			}
+
+			if !mp.MatchesShowExpression(&show, availableProviders) {
+				continue
+			}
+
+			resultsFound++
+
+			if err := processFunc(&show, availableProviders); err != nil {
+				continue
+			}
+		}
+
+		if page >= resp.TotalPages {
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package processor
+
+import (
+	"strconv"
+
+	"github.com/sebastianneubert/tmdb/internal/expr"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// MovieRecord maps a movie and its available providers onto the fields of a --where expression
+func MovieRecord(movie *models.Movie, genreNames, providers []string) expr.Record {
+	date := movie.ReleaseDate
+	if date == "" {
+		date = movie.FirstAirDate
+	}
+
+	return expr.Record{
+		"title":          expr.String(movie.GetTitle()),
+		"original_title": expr.String(movie.OriginalTitle),
+		"type":           expr.String("movie"),
+		"language":       expr.String(movie.OriginalLanguage),
+		"countries":      expr.Strings(movie.OriginCountry),
+		"rating":         expr.Number(movie.VoteAverage),
+		"votes":          expr.Number(float64(movie.VoteCount)),
+		"popularity":     expr.Number(movie.Popularity),
+		"year":           expr.Number(yearOf(date)),
+		"date":           expr.String(date),
+		"runtime":        expr.Number(float64(movie.Runtime)),
+		"genres":         expr.Strings(genreNames),
+		"providers":      expr.Strings(providers),
+	}
+}
+
+// ShowRecord maps a show and its available providers onto the fields of a --where expression
+func ShowRecord(show *models.Show, genreNames, providers []string) expr.Record {
+	return expr.Record{
+		"title":          expr.String(show.GetTitle()),
+		"original_title": expr.String(show.OriginalName),
+		"type":           expr.String("tv"),
+		"language":       expr.String(show.OriginalLanguage),
+		"countries":      expr.Strings(show.OriginCountry),
+		"rating":         expr.Number(show.VoteAverage),
+		"votes":          expr.Number(float64(show.VoteCount)),
+		"popularity":     expr.Number(show.Popularity),
+		"year":           expr.Number(yearOf(show.FirstAirDate)),
+		"date":           expr.String(show.FirstAirDate),
+		"runtime":        expr.Number(float64(show.GetEpisodeRuntime())),
+		"genres":         expr.Strings(genreNames),
+		"providers":      expr.Strings(providers),
+	}
+}
+
+// MatchesMovieExpression evaluates the --where expression, if any, against a movie
+func (mp *MovieProcessor) MatchesMovieExpression(movie *models.Movie, providers []string) bool {
+	if mp.config.Where == nil {
+		return true
+	}
+	genreNames := mp.recordGenreNames(filters.GetGenreIDs(movie))
+	return mp.config.Where.Eval(MovieRecord(movie, genreNames, providers))
+}
+
+// MatchesShowExpression evaluates the --where expression, if any, against a show
+func (mp *MovieProcessor) MatchesShowExpression(show *models.Show, providers []string) bool {
+	if mp.config.Where == nil {
+		return true
+	}
+	genreNames := mp.recordGenreNames(show.GenreIDs)
+	return mp.config.Where.Eval(ShowRecord(show, genreNames, providers))
+}
+
+// recordGenreNames names the genres of a record in English, so that expressions like
+// "Comedy" in genres match regardless of the display language
+func (mp *MovieProcessor) recordGenreNames(genreIDs []int) []string {
+	if len(mp.config.EnglishGenreList) > 0 {
+		return filters.GetGenreNames(genreIDs, mp.config.EnglishGenreList)
+	}
+	return filters.GetGenreNames(genreIDs, mp.config.GenreList)
+}
+
+func yearOf(date string) float64 {
+	if len(date) < 4 {
+		return 0
+	}
+	year, _ := strconv.Atoi(date[:4])
+	return float64(year)
+}
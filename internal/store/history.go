@@ -0,0 +1,60 @@
+package store
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// MaxHistory is the number of lines loaded from a history file
+const MaxHistory = 500
+
+// HistoryPath returns the path of the shell history in the data directory
+func HistoryPath() (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "shell_history"), nil
+}
+
+// LoadHistory reads the last MaxHistory lines of a history file, none if it doesn't exist
+func LoadHistory(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) > MaxHistory {
+		lines = lines[len(lines)-MaxHistory:]
+	}
+	return lines, scanner.Err()
+}
+
+// AppendHistory adds a line to a history file, creating the data directory if needed
+func AppendHistory(path, line string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteString(line + "\n"); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
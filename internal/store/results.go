@@ -0,0 +1,42 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/sebastianneubert/tmdb/internal/display"
+)
+
+// ResultsPath returns the path of the last displayed list in the data directory
+func ResultsPath() (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "last_results.json"), nil
+}
+
+// SaveResults stores the numbered results of the last list, so later runs can refer
+// to them by number, e.g. `tmdb watchlist add 3`
+func SaveResults(path string, results []display.Result) error {
+	return writeJSON(path, results)
+}
+
+// LoadResults reads the results of the last list, none if there is no list yet
+func LoadResults(path string) ([]display.Result, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var results []display.Result
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("invalid results file %s: %w", path, err)
+	}
+	return results, nil
+}
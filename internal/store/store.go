@@ -0,0 +1,145 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// Names of the lists: bookmarked titles and the seen history
+const (
+	Watchlist = "watchlist"
+	Seen      = "seen"
+)
+
+// DataDir returns the directory for local data: $XDG_DATA_HOME/tmdb, or
+// ~/.local/share/tmdb if XDG_DATA_HOME is not set
+func DataDir() (string, error) {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return filepath.Join(dir, "tmdb"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("cannot determine the data directory: %w", err)
+	}
+	return filepath.Join(home, ".local", "share", "tmdb"), nil
+}
+
+// Item is a movie or show stored in a list
+type Item struct {
+	ID        int       `json:"id"`
+	MediaType string    `json:"media_type"`
+	Title     string    `json:"title"`
+	Year      string    `json:"year,omitempty"`
+	AddedAt   time.Time `json:"added_at"`
+	// Rating is the user's own rating of a seen title from 1 to 10, 0 if not rated
+	Rating float64 `json:"rating,omitempty"`
+}
+
+// List is a named list of titles stored as JSON in the data directory
+type List struct {
+	Path  string `json:"-"`
+	Items []Item `json:"items"`
+}
+
+// OpenList loads the list with the given name, or an empty list if it doesn't exist yet
+func OpenList(name string) (*List, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return nil, err
+	}
+	return LoadList(filepath.Join(dir, name+".json"))
+}
+
+// LoadList loads a list from a file, or an empty list if the file doesn't exist
+func LoadList(path string) (*List, error) {
+	list := &List{Path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return list, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, list); err != nil {
+		return nil, fmt.Errorf("invalid list file %s: %w", path, err)
+	}
+	return list, nil
+}
+
+// Save writes the list, creating the data directory if needed
+func (l *List) Save() error {
+	return writeJSON(l.Path, l)
+}
+
+// writeJSON writes a value as JSON, creating the directory if needed. It writes to a
+// temporary file first so a failed write keeps the old file.
+func writeJSON(path string, v any) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Find returns the index of the title, -1 if it is not in the list
+func (l *List) Find(mediaType string, id int) int {
+	for i, item := range l.Items {
+		if item.ID == id && item.MediaType == mediaType {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains reports whether the title is in the list
+func (l *List) Contains(mediaType string, id int) bool {
+	return l.Find(mediaType, id) >= 0
+}
+
+// Add appends the item unless the title is already in the list
+func (l *List) Add(item Item) bool {
+	if l.Contains(item.MediaType, item.ID) {
+		return false
+	}
+	if item.AddedAt.IsZero() {
+		item.AddedAt = time.Now()
+	}
+	l.Items = append(l.Items, item)
+	return true
+}
+
+// Remove removes the title, false if it was not in the list
+func (l *List) Remove(mediaType string, id int) bool {
+	i := l.Find(mediaType, id)
+	if i < 0 {
+		return false
+	}
+	l.Items = append(l.Items[:i], l.Items[i+1:]...)
+	return true
+}
+
+// NewItem creates a list item for a movie or show
+func NewItem(movie models.Movie, now time.Time) Item {
+	return Item{
+		ID:        movie.ID,
+		MediaType: movie.GetMediaType(),
+		Title:     movie.GetTitle(),
+		Year:      strings.Trim(movie.GetYear(), "()"),
+		AddedAt:   now,
+	}
+}
@@ -0,0 +1,627 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// LoadFunc loads a page of titles for a query (empty for lists without a query)
+type LoadFunc func(query string, page int) (items []models.Movie, totalPages int, err error)
+
+// Source is a tab of the browser, e.g. top rated movies or search results
+type Source struct {
+	Name string
+	// Prompt asks for a query before loading, e.g. "Search"; empty for plain lists
+	Prompt string
+	Load   LoadFunc
+}
+
+// Focus areas of the browser, cycled with tab
+const (
+	focusList = iota
+	focusRating
+	focusProviders
+	focusGenres
+	focusCount
+)
+
+// prefetchRows loads the next page when the cursor gets this close to the end
+const prefetchRows = 5
+
+// maxPagesPerFill limits the pages loaded at once when filters hide most titles
+const maxPagesPerFill = 3
+
+// Browser is a full-screen list/detail view over several sources with filter controls
+type Browser struct {
+	Sources []Source
+	// Providers fetches the watch providers of a title in the region
+	Providers func(item models.Movie) (models.RegionProviders, error)
+	// Bookmark adds a title to the local list, false if it was already there
+	Bookmark     func(item models.Movie) (bool, error)
+	IsBookmarked func(item models.Movie) bool
+
+	source     int
+	query      string
+	items      []models.Movie
+	page       int
+	totalPages int
+	cursor     int
+	offset     int
+
+	minRating     float64
+	providerNames []string
+	providerOn    []bool
+	genreNames    []string
+	genreOn       []bool
+	genreByID     map[int]string
+
+	focus int
+	chip  int
+
+	providers map[string]models.RegionProviders
+
+	// input is the query being typed for a source with a prompt, nil if not typing
+	input  *string
+	status string
+	done   bool
+}
+
+// NewBrowser creates a browser with all providers enabled and no genre selected
+func NewBrowser(sources []Source, providerNames []string, genres []models.Genre) *Browser {
+	b := &Browser{
+		Sources:       sources,
+		providerNames: providerNames,
+		providerOn:    make([]bool, len(providerNames)),
+		genreByID:     make(map[int]string),
+		providers:     make(map[string]models.RegionProviders),
+	}
+	for i := range b.providerOn {
+		b.providerOn[i] = true
+	}
+	for _, genre := range genres {
+		if _, ok := b.genreByID[genre.ID]; !ok {
+			b.genreByID[genre.ID] = genre.Name
+		}
+		if !containsString(b.genreNames, genre.Name) {
+			b.genreNames = append(b.genreNames, genre.Name)
+		}
+	}
+	b.genreOn = make([]bool, len(b.genreNames))
+	return b
+}
+
+// Done reports whether the user quit
+func (b *Browser) Done() bool {
+	return b.done
+}
+
+// Start selects the first source
+func (b *Browser) Start() {
+	b.selectSource(0)
+}
+
+// Update applies a key press
+func (b *Browser) Update(key Key) {
+	if b.input != nil {
+		b.updateInput(key)
+		return
+	}
+
+	switch {
+	case key.Name == KeyCtrlC || key.Rune == 'q':
+		b.done = true
+		return
+	case key.Name == KeyEscape:
+		if b.focus == focusList {
+			b.done = true
+		}
+		b.focus = focusList
+		return
+	case key.Name == KeyTab:
+		b.focus = (b.focus + 1) % focusCount
+		b.chip = 0
+		return
+	case key.Rune == '/':
+		for i, source := range b.Sources {
+			if source.Prompt != "" {
+				b.selectSource(i)
+				return
+			}
+		}
+	case key.Rune == 'b':
+		b.bookmarkSelected()
+		return
+	case key.Rune >= '1' && key.Rune <= '9':
+		if i := int(key.Rune - '1'); i < len(b.Sources) {
+			b.selectSource(i)
+		}
+		return
+	}
+
+	switch b.focus {
+	case focusList:
+		b.updateList(key)
+	case focusRating:
+		b.updateRating(key)
+	case focusProviders:
+		if b.updateChips(key, b.providerOn) {
+			b.refilter()
+		}
+	case focusGenres:
+		if b.updateChips(key, b.genreOn) {
+			b.refilter()
+		}
+	}
+}
+
+func (b *Browser) updateInput(key Key) {
+	switch key.Name {
+	case KeyEnter:
+		b.query = *b.input
+		b.input = nil
+		b.reload()
+	case KeyEscape, KeyCtrlC:
+		b.input = nil
+	case KeyBackspace:
+		if runes := []rune(*b.input); len(runes) > 0 {
+			*b.input = string(runes[:len(runes)-1])
+		}
+	case "":
+		*b.input += string(key.Rune)
+	}
+}
+
+func (b *Browser) updateList(key Key) {
+	switch {
+	case key.Name == KeyUp || key.Rune == 'k':
+		if b.cursor > 0 {
+			b.cursor--
+		}
+	case key.Name == KeyDown || key.Rune == 'j':
+		if b.cursor < len(b.visible())-1 {
+			b.cursor++
+		}
+	}
+	b.fill()
+}
+
+func (b *Browser) updateRating(key Key) {
+	switch {
+	case key.Name == KeyLeft || key.Rune == 'h':
+		b.minRating = max(0, b.minRating-0.5)
+	case key.Name == KeyRight || key.Rune == 'l':
+		b.minRating = min(9.5, b.minRating+0.5)
+	default:
+		return
+	}
+	b.refilter()
+}
+
+// updateChips moves the chip cursor or toggles a chip, true if a chip was toggled
+func (b *Browser) updateChips(key Key, on []bool) bool {
+	switch {
+	case key.Name == KeyLeft || key.Rune == 'h':
+		if b.chip > 0 {
+			b.chip--
+		}
+	case key.Name == KeyRight || key.Rune == 'l':
+		if b.chip < len(on)-1 {
+			b.chip++
+		}
+	case key.Rune == ' ' || key.Name == KeyEnter:
+		if b.chip < len(on) {
+			on[b.chip] = !on[b.chip]
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Browser) selectSource(i int) {
+	b.source = i
+	b.query = ""
+	b.items = nil
+	b.page, b.totalPages = 0, 0
+	b.cursor, b.offset = 0, 0
+	b.status = ""
+	if b.Sources[i].Prompt != "" {
+		input := ""
+		b.input = &input
+		return
+	}
+	b.reload()
+}
+
+func (b *Browser) reload() {
+	b.items = nil
+	b.page, b.totalPages = 0, 1
+	b.cursor, b.offset = 0, 0
+	b.status = ""
+	b.fill()
+}
+
+// refilter keeps the cursor in range after a filter change and loads more titles if needed
+func (b *Browser) refilter() {
+	b.cursor, b.offset = 0, 0
+	if b.providerFilterActive() {
+		for _, item := range b.items {
+			b.providersOf(item)
+		}
+	}
+	b.fill()
+}
+
+// fill loads pages lazily until the cursor is far enough from the end of the visible titles
+func (b *Browser) fill() {
+	for loaded := 0; loaded < maxPagesPerFill && b.page < b.totalPages; loaded++ {
+		if len(b.visible())-b.cursor > prefetchRows {
+			break
+		}
+		if !b.loadPage(b.page + 1) {
+			break
+		}
+	}
+	if visible := b.visible(); b.cursor >= len(visible) {
+		b.cursor = max(0, len(visible)-1)
+	}
+	if item, ok := b.Selected(); ok {
+		b.providersOf(item)
+	}
+}
+
+func (b *Browser) loadPage(page int) bool {
+	items, totalPages, err := b.Sources[b.source].Load(b.query, page)
+	if err != nil {
+		b.status = "Error: " + err.Error()
+		return false
+	}
+	b.page, b.totalPages = page, totalPages
+	b.items = append(b.items, items...)
+	if b.providerFilterActive() {
+		for _, item := range items {
+			b.providersOf(item)
+		}
+	}
+	return len(items) > 0
+}
+
+// providersOf fetches the watch providers of a title once
+func (b *Browser) providersOf(item models.Movie) (models.RegionProviders, bool) {
+	key := titleKey(item)
+	if providers, ok := b.providers[key]; ok {
+		return providers, true
+	}
+	if b.Providers == nil {
+		return models.RegionProviders{}, false
+	}
+	providers, err := b.Providers(item)
+	if err != nil {
+		return models.RegionProviders{}, false
+	}
+	b.providers[key] = providers
+	return providers, true
+}
+
+func (b *Browser) bookmarkSelected() {
+	item, ok := b.Selected()
+	if !ok || b.Bookmark == nil {
+		return
+	}
+	added, err := b.Bookmark(item)
+	switch {
+	case err != nil:
+		b.status = "Error: " + err.Error()
+	case added:
+		b.status = "Added " + item.GetTitle() + " to your watchlist"
+	default:
+		b.status = item.GetTitle() + " is already on your watchlist"
+	}
+}
+
+// Selected returns the title under the cursor
+func (b *Browser) Selected() (models.Movie, bool) {
+	visible := b.visible()
+	if b.cursor < len(visible) {
+		return visible[b.cursor], true
+	}
+	return models.Movie{}, false
+}
+
+func (b *Browser) providerFilterActive() bool {
+	return containsBool(b.providerOn, true)
+}
+
+func (b *Browser) desiredProviders() map[string]bool {
+	desired := make(map[string]bool)
+	for i, name := range b.providerNames {
+		if b.providerOn[i] {
+			desired[strings.ToLower(name)] = true
+		}
+	}
+	return desired
+}
+
+// available returns the enabled providers a title streams on, false if unknown
+func (b *Browser) available(item models.Movie) ([]string, bool) {
+	providers, ok := b.providers[titleKey(item)]
+	if !ok {
+		return nil, false
+	}
+	available, _ := filters.CheckAvailability(providers, b.desiredProviders())
+	return available, true
+}
+
+// visible returns the loaded titles passing the rating, genre and provider filters
+func (b *Browser) visible() []models.Movie {
+	genresActive := containsBool(b.genreOn, true)
+	providersActive := b.providerFilterActive()
+
+	var visible []models.Movie
+	for _, item := range b.items {
+		if item.VoteAverage < b.minRating {
+			continue
+		}
+		if genresActive && !b.hasSelectedGenre(item) {
+			continue
+		}
+		if providersActive {
+			if available, ok := b.available(item); !ok || len(available) == 0 {
+				continue
+			}
+		}
+		visible = append(visible, item)
+	}
+	return visible
+}
+
+func (b *Browser) hasSelectedGenre(item models.Movie) bool {
+	for _, id := range item.GenreIDs {
+		for i, name := range b.genreNames {
+			if b.genreOn[i] && b.genreByID[id] == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// View renders the browser for a terminal of the given size
+func (b *Browser) View(width, height int) string {
+	width = max(width, 40)
+	height = max(height, 12)
+
+	lines := []string{
+		b.viewTabs(),
+		b.viewRating(),
+		b.viewChips("Providers", focusProviders, b.providerNames, b.providerOn, width),
+		b.viewChips("Genres", focusGenres, b.genreNames, b.genreOn, width),
+		display.SeparatorStyle.Render(strings.Repeat("─", width)),
+	}
+
+	bodyHeight := height - len(lines) - 2
+	listWidth := width * 2 / 5
+	detailWidth := width - listWidth - 3
+
+	list := lipgloss.NewStyle().Width(listWidth).Height(bodyHeight).MaxHeight(bodyHeight).Render(b.viewList(listWidth, bodyHeight))
+	detail := lipgloss.NewStyle().Width(detailWidth).Height(bodyHeight).MaxHeight(bodyHeight).Render(b.viewDetail(detailWidth))
+	divider := display.SeparatorStyle.Render(strings.TrimSuffix(strings.Repeat(" │\n", bodyHeight), "\n"))
+	lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top, list, divider, " ", detail))
+
+	lines = append(lines, display.SeparatorStyle.Render(strings.Repeat("─", width)), b.viewFooter())
+	return strings.Join(lines, "\n")
+}
+
+func (b *Browser) viewTabs() string {
+	tabs := []string{display.TitleStyle.Render("TMDb")}
+	for i, source := range b.Sources {
+		label := fmt.Sprintf("%d %s", i+1, source.Name)
+		if i == b.source {
+			label = display.HighlightStyle.Render(label)
+			if b.query != "" {
+				label += " " + display.OriginalTitleStyle.Render(strconv.Quote(b.query))
+			}
+		}
+		tabs = append(tabs, label)
+	}
+	return strings.Join(tabs, "  ")
+}
+
+func (b *Browser) viewRating() string {
+	const steps = 20
+	filled := int(b.minRating * 2)
+	slider := display.RatingStyle.Render(strings.Repeat("█", filled)) + display.SeparatorStyle.Render(strings.Repeat("░", steps-filled))
+	label := focusLabel("Min rating", b.focus == focusRating)
+	return fmt.Sprintf("%s ◀ %s ▶ %s", label, slider, display.RatingStyle.Render(fmt.Sprintf("%.1f", b.minRating)))
+}
+
+func (b *Browser) viewChips(name string, focus int, names []string, on []bool, width int) string {
+	focused := b.focus == focus
+	chips := make([]string, len(names))
+	for i, chipName := range names {
+		chip := "[ ] " + chipName
+		if on[i] {
+			chip = display.ProviderStyle.Render("[x] " + chipName)
+		}
+		if focused && i == b.chip {
+			chip = display.HighlightStyle.Render(chip)
+		}
+		chips[i] = chip
+	}
+
+	// Scroll the chips so the one under the cursor stays visible
+	start := 0
+	if focused {
+		for start < b.chip && lipgloss.Width(strings.Join(chips[start:b.chip+1], " ")) > width-len(name)-6 {
+			start++
+		}
+	}
+	line := focusLabel(name, focused) + " " + strings.Join(chips[start:], " ")
+	if start > 0 {
+		line = focusLabel(name, focused) + " … " + strings.Join(chips[start:], " ")
+	}
+	return lipgloss.NewStyle().MaxWidth(width).Render(line)
+}
+
+func (b *Browser) viewList(width, height int) string {
+	if b.input != nil {
+		return fmt.Sprintf("%s: %s█", b.Sources[b.source].Prompt, *b.input)
+	}
+
+	visible := b.visible()
+	if len(visible) == 0 {
+		if b.page < b.totalPages {
+			return "Loading..."
+		}
+		return "No titles match the filters."
+	}
+
+	// Scroll so the cursor stays within the list
+	if b.cursor < b.offset {
+		b.offset = b.cursor
+	}
+	if b.cursor >= b.offset+height {
+		b.offset = b.cursor - height + 1
+	}
+
+	var rows []string
+	for i := b.offset; i < len(visible) && i < b.offset+height; i++ {
+		item := visible[i]
+		marker := "  "
+		if b.IsBookmarked != nil && b.IsBookmarked(item) {
+			marker = "★ "
+		}
+		text := truncate(fmt.Sprintf("%s%s %s", marker, item.GetTitle(), item.GetYear()), width-6)
+		row := fmt.Sprintf("%-*s %s", width-5, text, fmt.Sprintf("%.1f", item.VoteAverage))
+		if i == b.cursor && b.focus == focusList {
+			row = display.HighlightStyle.Render(row)
+		} else if i == b.cursor {
+			row = display.TitleStyle.Render(row)
+		}
+		rows = append(rows, row)
+	}
+	return strings.Join(rows, "\n")
+}
+
+func (b *Browser) viewDetail(width int) string {
+	item, ok := b.Selected()
+	if !ok {
+		return ""
+	}
+
+	var lines []string
+	lines = append(lines, display.TitleStyle.Render(item.GetTitle())+" "+item.GetYear())
+	if item.IsShow() {
+		lines = append(lines, display.ShowBadgeStyle.Render("TV"))
+	}
+	lines = append(lines, fmt.Sprintf("Rating: %s/10 (Votes: %d)", display.RatingStyle.Render(fmt.Sprintf("%.1f", item.VoteAverage)), item.VoteCount))
+
+	var genres []string
+	for _, id := range item.GenreIDs {
+		if name, ok := b.genreByID[id]; ok {
+			genres = append(genres, name)
+		}
+	}
+	if len(genres) > 0 {
+		lines = append(lines, "Genres: "+display.OriginalTitleStyle.Render(strings.Join(genres, ", ")))
+	}
+	if item.OriginalLanguage != "" {
+		lines = append(lines, "Language: "+models.FormatLanguage(item.OriginalLanguage))
+	}
+	if item.Character != "" {
+		lines = append(lines, "Character: "+item.Character)
+	}
+
+	if providers, ok := b.providers[titleKey(item)]; ok {
+		available, _ := filters.CheckAvailability(providers, b.desiredProviders())
+		if len(available) > 0 {
+			lines = append(lines, "STREAMING on: "+display.ProviderStyle.Render(strings.Join(available, ", ")))
+		} else {
+			lines = append(lines, "STREAMING on: "+display.OriginalTitleStyle.Render("not on your providers"))
+		}
+	}
+	if b.IsBookmarked != nil && b.IsBookmarked(item) {
+		lines = append(lines, display.RatingStyle.Render("★ On your watchlist"))
+	}
+
+	lines = append(lines, "", lipgloss.NewStyle().Width(width).Render(item.Overview))
+	return strings.Join(lines, "\n")
+}
+
+func (b *Browser) viewFooter() string {
+	if b.status != "" {
+		return b.status
+	}
+	if b.input != nil {
+		return "enter search · esc cancel"
+	}
+	help := "1-9 source · / search · tab filters · ↑/↓ move · b bookmark · q quit"
+	if b.focus != focusList {
+		help = "←/→ adjust · space toggle · tab next · esc back to list"
+	}
+	return display.OriginalTitleStyle.Render(help) + fmt.Sprintf("  %d/%d pages", b.page, b.totalPages)
+}
+
+func focusLabel(label string, focused bool) string {
+	if focused {
+		return display.HighlightStyle.Render(label)
+	}
+	return label + ":"
+}
+
+func titleKey(item models.Movie) string {
+	return item.GetMediaType() + ":" + strconv.Itoa(item.ID)
+}
+
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if width <= 1 || len(runes) <= width {
+		return s
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsBool(values []bool, value bool) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Run shows the browser full screen until the user quits
+func Run(b *Browser) error {
+	screen, err := OpenScreen()
+	if err != nil {
+		return err
+	}
+	defer screen.Close()
+	screen.EnterFullScreen()
+	defer screen.ExitFullScreen()
+
+	b.Start()
+	for !b.Done() {
+		screen.Render(b.View(screen.Size()))
+		key, err := screen.ReadKey()
+		if err != nil {
+			return err
+		}
+		b.Update(key)
+	}
+	return nil
+}
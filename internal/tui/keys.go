@@ -0,0 +1,168 @@
+package tui
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// Key is a key press read from a terminal in raw mode
+type Key struct {
+	// Name is set for special keys: up, down, left, right, enter, backspace, escape, tab, ctrl+c
+	Name string
+	// Rune is set for printable characters
+	Rune rune
+}
+
+// Special key names
+const (
+	KeyUp        = "up"
+	KeyDown      = "down"
+	KeyLeft      = "left"
+	KeyRight     = "right"
+	KeyEnter     = "enter"
+	KeyBackspace = "backspace"
+	KeyEscape    = "escape"
+	KeyTab       = "tab"
+	KeyCtrlC     = "ctrl+c"
+)
+
+// IsInteractive reports whether stdin and stdout are both terminals
+func IsInteractive() bool {
+	return term.IsTerminal(os.Stdin.Fd()) && term.IsTerminal(os.Stdout.Fd())
+}
+
+// ReadKey reads a single key press. Arrow keys are decoded from their
+// "ESC [ A" sequences; a lone ESC is the escape key.
+func ReadKey(r *bufio.Reader) (Key, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return Key{}, err
+	}
+
+	switch b {
+	case '\r', '\n':
+		return Key{Name: KeyEnter}, nil
+	case 127, '\b':
+		return Key{Name: KeyBackspace}, nil
+	case '\t':
+		return Key{Name: KeyTab}, nil
+	case 3:
+		return Key{Name: KeyCtrlC}, nil
+	case 27:
+		return readEscape(r)
+	}
+
+	if err := r.UnreadByte(); err != nil {
+		return Key{}, err
+	}
+	ch, _, err := r.ReadRune()
+	if err != nil {
+		return Key{}, err
+	}
+	return Key{Rune: ch}, nil
+}
+
+func readEscape(r *bufio.Reader) (Key, error) {
+	// A lone ESC has nothing buffered after it
+	if r.Buffered() == 0 {
+		return Key{Name: KeyEscape}, nil
+	}
+	next, err := r.ReadByte()
+	if err != nil {
+		return Key{Name: KeyEscape}, nil
+	}
+	if next != '[' && next != 'O' {
+		return Key{Name: KeyEscape}, nil
+	}
+	code, err := r.ReadByte()
+	if err != nil {
+		return Key{Name: KeyEscape}, nil
+	}
+	switch code {
+	case 'A':
+		return Key{Name: KeyUp}, nil
+	case 'B':
+		return Key{Name: KeyDown}, nil
+	case 'C':
+		return Key{Name: KeyRight}, nil
+	case 'D':
+		return Key{Name: KeyLeft}, nil
+	}
+	return Key{Name: KeyEscape}, nil
+}
+
+// Screen is a terminal in raw mode. Lines written with Draw replace the previously
+// drawn lines, so views can be redrawn in place.
+type Screen struct {
+	in    *bufio.Reader
+	out   io.Writer
+	fd    uintptr
+	state *term.State
+	drawn int
+}
+
+// OpenScreen puts the terminal into raw mode; Close restores it
+func OpenScreen() (*Screen, error) {
+	fd := os.Stdin.Fd()
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, err
+	}
+	return &Screen{in: bufio.NewReader(os.Stdin), out: os.Stdout, fd: fd, state: state}, nil
+}
+
+// ReadKey waits for the next key press
+func (s *Screen) ReadKey() (Key, error) {
+	return ReadKey(s.in)
+}
+
+// Draw replaces the previously drawn lines with the given ones
+func (s *Screen) Draw(lines []string) {
+	s.Clear()
+	for _, line := range lines {
+		io.WriteString(s.out, line+"\r\n")
+	}
+	s.drawn = len(lines)
+}
+
+// Clear removes the previously drawn lines
+func (s *Screen) Clear() {
+	if s.drawn > 0 {
+		io.WriteString(s.out, "\x1b["+strconv.Itoa(s.drawn)+"A\r\x1b[J")
+	}
+	s.drawn = 0
+}
+
+// Close restores the terminal state
+func (s *Screen) Close() error {
+	return term.Restore(s.fd, s.state)
+}
+
+// Size returns the terminal width and height
+func (s *Screen) Size() (width, height int) {
+	width, height, err := term.GetSize(os.Stdout.Fd())
+	if err != nil {
+		return 80, 24
+	}
+	return width, height
+}
+
+// EnterFullScreen switches to the alternate screen and hides the cursor
+func (s *Screen) EnterFullScreen() {
+	io.WriteString(s.out, "\x1b[?1049h\x1b[?25l")
+}
+
+// ExitFullScreen shows the cursor and returns to the normal screen
+func (s *Screen) ExitFullScreen() {
+	io.WriteString(s.out, "\x1b[?25h\x1b[?1049l")
+}
+
+// Render replaces the whole full screen with the given view
+func (s *Screen) Render(view string) {
+	io.WriteString(s.out, "\x1b[H\x1b[2J"+strings.ReplaceAll(view, "\n", "\r\n"))
+}
@@ -0,0 +1,166 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Item is an entry of a Picker
+type Item struct {
+	Title string
+	// Detail is shown below the title, e.g. the known-for titles of a person
+	Detail string
+}
+
+// Picker selects one of several items with the arrow keys (or j/k) and enter,
+// or by typing its number. Escape, q and ctrl+c cancel.
+type Picker struct {
+	Prompt string
+	Items  []Item
+	Cursor int
+	// typed holds the digits typed so far
+	typed string
+	// top is the first visible item when not all items fit on the screen
+	top int
+}
+
+// Update applies a key press. It returns done once an item was selected or the
+// picker was cancelled, and whether an item (Cursor) was selected.
+func (p *Picker) Update(key Key) (done, selected bool) {
+	switch key.Name {
+	case KeyUp:
+		p.typed = ""
+		if p.Cursor > 0 {
+			p.Cursor--
+		}
+	case KeyDown, KeyTab:
+		p.typed = ""
+		if p.Cursor < len(p.Items)-1 {
+			p.Cursor++
+		}
+	case KeyEnter:
+		return true, len(p.Items) > 0
+	case KeyEscape, KeyCtrlC:
+		return true, false
+	case KeyBackspace:
+		if p.typed != "" {
+			p.typed = p.typed[:len(p.typed)-1]
+			p.moveToTyped()
+		}
+	case "":
+		switch {
+		case key.Rune >= '0' && key.Rune <= '9':
+			p.typed += string(key.Rune)
+			if !p.moveToTyped() {
+				// Start over with the new digit if the number got too large
+				p.typed = string(key.Rune)
+				p.moveToTyped()
+			}
+		case key.Rune == 'k':
+			return p.Update(Key{Name: KeyUp})
+		case key.Rune == 'j':
+			return p.Update(Key{Name: KeyDown})
+		case key.Rune == 'q':
+			return true, false
+		}
+	}
+	return false, false
+}
+
+// moveToTyped moves the cursor to the typed number, false if there is no such item
+func (p *Picker) moveToTyped() bool {
+	n, err := strconv.Atoi(p.typed)
+	if err != nil || n < 1 || n > len(p.Items) {
+		return false
+	}
+	p.Cursor = n - 1
+	return true
+}
+
+// View renders the picker in at most height lines, one string per line. When not
+// all items fit, the list scrolls to keep the cursor visible. A height of 0 shows
+// all items.
+func (p *Picker) View(height int) []string {
+	first, last := 0, len(p.Items)
+	if height > 0 {
+		// The prompt, a blank line and the help take three lines
+		first, last = p.window(height - 3)
+	}
+
+	lines := []string{p.Prompt}
+	for i := first; i < last; i++ {
+		item := p.Items[i]
+		marker := "  "
+		if i == p.Cursor {
+			marker = "> "
+		}
+		lines = append(lines, fmt.Sprintf("%s%2d. %s", marker, i+1, item.Title))
+		if item.Detail != "" {
+			lines = append(lines, "       "+item.Detail)
+		}
+	}
+	help := "↑/↓ or number to move, enter to select, esc to cancel"
+	if first > 0 || last < len(p.Items) {
+		help = fmt.Sprintf("%d-%d of %d, %s", first+1, last, len(p.Items), help)
+	}
+	lines = append(lines, "", help)
+	return lines
+}
+
+// window returns the range of items that fit in the given number of lines,
+// moving the top of the window only as far as needed to show the cursor
+func (p *Picker) window(available int) (first, last int) {
+	if p.Cursor < p.top {
+		p.top = p.Cursor
+	}
+	// Scroll down until the cursor fits, but always show the cursor item
+	for p.top < p.Cursor && p.itemLines(p.top, p.Cursor+1) > available {
+		p.top++
+	}
+	last = p.Cursor + 1
+	for last < len(p.Items) && p.itemLines(p.top, last+1) <= available {
+		last++
+	}
+	// Scroll back up if there is room left, e.g. after the terminal grew
+	for p.top > 0 && p.itemLines(p.top-1, last) <= available {
+		p.top--
+	}
+	return p.top, last
+}
+
+// itemLines returns the number of lines the items first to last-1 take
+func (p *Picker) itemLines(first, last int) int {
+	lines := 0
+	for _, item := range p.Items[first:last] {
+		lines++
+		if item.Detail != "" {
+			lines++
+		}
+	}
+	return lines
+}
+
+// Pick runs the picker on the terminal and returns the selected index,
+// false if the user cancelled
+func Pick(picker *Picker) (int, bool, error) {
+	screen, err := OpenScreen()
+	if err != nil {
+		return 0, false, err
+	}
+	defer screen.Close()
+
+	for {
+		// Keep the last row free, drawing into it would scroll the first line away
+		_, height := screen.Size()
+		screen.Draw(picker.View(height - 1))
+		key, err := screen.ReadKey()
+		if err != nil {
+			screen.Clear()
+			return 0, false, err
+		}
+		if done, selected := picker.Update(key); done {
+			screen.Clear()
+			return picker.Cursor, selected, nil
+		}
+	}
+}
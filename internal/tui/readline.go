@@ -0,0 +1,254 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrUnterminatedQuote is returned by SplitWords for a line with an open quote
+var ErrUnterminatedQuote = errors.New("unterminated quote")
+
+// ErrInterrupt is returned by LineEditor for ctrl+c, io.EOF for ctrl+d on an empty line
+var ErrInterrupt = errors.New("interrupt")
+
+// SplitWords splits a command line into words like a shell: words are separated by
+// spaces, quotes group words ("Tom Hanks") and a backslash escapes the next character
+func SplitWords(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+
+	for _, ch := range line {
+		switch {
+		case escaped:
+			word.WriteRune(ch)
+			escaped = false
+		case ch == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			} else {
+				word.WriteRune(ch)
+			}
+		case ch == '"' || ch == '\'':
+			quote, inWord = ch, true
+		case ch == ' ' || ch == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(ch)
+			inWord = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, ErrUnterminatedQuote
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// CompleteFunc returns the candidates for the word being typed, given the words before it
+type CompleteFunc func(words []string, current string) []string
+
+// LineEditor reads lines with history (↑/↓) and tab completion
+type LineEditor struct {
+	Prompt   string
+	History  []string
+	Complete CompleteFunc
+
+	buf []rune
+	pos int
+	// history is the index of the history entry being edited, len(History) for a new line
+	history int
+	draft   string
+	// Candidates are the completions of an ambiguous tab press, to be listed below the line
+	Candidates []string
+}
+
+// Reset starts a new line
+func (e *LineEditor) Reset() {
+	e.buf, e.pos = nil, 0
+	e.history = len(e.History)
+	e.draft = ""
+	e.Candidates = nil
+}
+
+// Line returns the text typed so far
+func (e *LineEditor) Line() string {
+	return string(e.buf)
+}
+
+// Update applies a key press; done is set when the line was entered
+func (e *LineEditor) Update(key Key) (done bool, err error) {
+	e.Candidates = nil
+
+	switch key.Name {
+	case KeyEnter:
+		return true, nil
+	case KeyCtrlC:
+		return false, ErrInterrupt
+	case KeyBackspace:
+		if e.pos > 0 {
+			e.buf = append(e.buf[:e.pos-1], e.buf[e.pos:]...)
+			e.pos--
+		}
+	case KeyLeft:
+		if e.pos > 0 {
+			e.pos--
+		}
+	case KeyRight:
+		if e.pos < len(e.buf) {
+			e.pos++
+		}
+	case KeyUp:
+		e.recall(e.history - 1)
+	case KeyDown:
+		e.recall(e.history + 1)
+	case KeyTab:
+		e.complete()
+	case KeyEscape:
+	case "":
+		switch key.Rune {
+		case 4: // ctrl+d
+			if len(e.buf) == 0 {
+				return false, io.EOF
+			}
+		case 1: // ctrl+a
+			e.pos = 0
+		case 5: // ctrl+e
+			e.pos = len(e.buf)
+		case 21: // ctrl+u
+			e.buf, e.pos = e.buf[e.pos:], 0
+		default:
+			if key.Rune >= ' ' {
+				e.insert(string(key.Rune))
+			}
+		}
+	}
+	return false, nil
+}
+
+func (e *LineEditor) insert(text string) {
+	runes := []rune(text)
+	buf := make([]rune, 0, len(e.buf)+len(runes))
+	buf = append(buf, e.buf[:e.pos]...)
+	buf = append(buf, runes...)
+	e.buf = append(buf, e.buf[e.pos:]...)
+	e.pos += len(runes)
+}
+
+// recall replaces the line with a history entry, keeping the new line as a draft
+func (e *LineEditor) recall(index int) {
+	if index < 0 || index > len(e.History) {
+		return
+	}
+	if e.history == len(e.History) {
+		e.draft = string(e.buf)
+	}
+	e.history = index
+	if index == len(e.History) {
+		e.buf = []rune(e.draft)
+	} else {
+		e.buf = []rune(e.History[index])
+	}
+	e.pos = len(e.buf)
+}
+
+// complete extends the word before the cursor to the longest common prefix of the
+// candidates, adding a space if there is only one
+func (e *LineEditor) complete() {
+	if e.Complete == nil {
+		return
+	}
+	before := string(e.buf[:e.pos])
+	start := strings.LastIndexAny(before, " \t") + 1
+	words, err := SplitWords(before[:start])
+	if err != nil {
+		return
+	}
+	current := before[start:]
+
+	candidates := e.Complete(words, current)
+	if len(candidates) == 0 {
+		return
+	}
+	prefix := commonPrefix(candidates)
+	if len(candidates) == 1 {
+		prefix += " "
+	}
+	if len(prefix) > len(current) {
+		e.insert(prefix[len(current):])
+		return
+	}
+	e.Candidates = candidates
+}
+
+func commonPrefix(values []string) string {
+	prefix := values[0]
+	for _, value := range values[1:] {
+		for !strings.HasPrefix(value, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+// View returns the prompt and line, and the cursor column from the end of the line
+func (e *LineEditor) View() (line string, back int) {
+	return e.Prompt + string(e.buf), len(e.buf) - e.pos
+}
+
+// ReadLine reads a line from the terminal. It returns io.EOF for ctrl+d and
+// ErrInterrupt for ctrl+c. Entered lines are added to the history.
+func (e *LineEditor) ReadLine() (string, error) {
+	screen, err := OpenScreen()
+	if err != nil {
+		return "", err
+	}
+	defer screen.Close()
+
+	e.Reset()
+	e.render(screen)
+	for {
+		key, err := screen.ReadKey()
+		if err != nil {
+			return "", err
+		}
+		done, err := e.Update(key)
+		if err != nil {
+			io.WriteString(screen.out, "\r\n")
+			return "", err
+		}
+		if done {
+			io.WriteString(screen.out, "\r\n")
+			line := strings.TrimSpace(e.Line())
+			if line != "" && (len(e.History) == 0 || e.History[len(e.History)-1] != line) {
+				e.History = append(e.History, line)
+			}
+			return line, nil
+		}
+		if len(e.Candidates) > 0 {
+			io.WriteString(screen.out, "\r\n"+strings.Join(e.Candidates, "  ")+"\r\n")
+		}
+		e.render(screen)
+	}
+}
+
+func (e *LineEditor) render(screen *Screen) {
+	line, back := e.View()
+	io.WriteString(screen.out, "\r\x1b[K"+line)
+	if back > 0 {
+		io.WriteString(screen.out, fmt.Sprintf("\x1b[%dD", back))
+	}
+}
@@ -43,3 +43,117 @@ func TestMovieCommandFlagsRegisterAndResolve(t *testing.T) {
 		t.Errorf("Expected non-zero timeout")
 	}
 }
+
+func TestMovieCommandFlagsResolveScoring(t *testing.T) {
+	cmd := &cobra.Command{}
+	flags := commands.MovieCommandFlags{}
+	flags.Register(cmd, true)
+
+	cfg := config.Config{Scoring: "weighted", PriorMean: 7.0, PriorVotes: 250}
+
+	scoring, err := flags.ResolveScoring(cmd, cfg)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !scoring.Weighted || scoring.PriorMean != 7.0 || scoring.PriorVotes != 250 {
+		t.Errorf("Expected the config values, got %+v", scoring)
+	}
+
+	cmd.Flags().Set("scoring", "raw")
+	cmd.Flags().Set("prior-votes", "1000")
+	scoring, err = flags.ResolveScoring(cmd, cfg)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if scoring.Weighted || scoring.PriorVotes != 1000 {
+		t.Errorf("Expected flags to override the config, got %+v", scoring)
+	}
+
+	cmd.Flags().Set("scoring", "bayes")
+	if _, err := flags.ResolveScoring(cmd, cfg); err == nil {
+		t.Error("Expected an error for an invalid scoring mode")
+	}
+}
+
+func TestMovieCommandFlagsResolveScoringInvalidPrior(t *testing.T) {
+	cfg := config.Config{Scoring: "weighted", PriorMean: 7.0, PriorVotes: 250}
+
+	tests := []struct {
+		flag  string
+		value string
+	}{
+		{"prior-mean", "0"},
+		{"prior-mean", "-1"},
+		{"prior-mean", "11"},
+		{"prior-votes", "-5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.flag+"="+tt.value, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			flags := commands.MovieCommandFlags{}
+			flags.Register(cmd, true)
+			cmd.Flags().Set(tt.flag, tt.value)
+
+			if _, err := flags.ResolveScoring(cmd, cfg); err == nil {
+				t.Errorf("Expected an error for --%s %s", tt.flag, tt.value)
+			}
+		})
+	}
+}
+
+func TestMovieCommandFlagsResolveCertification(t *testing.T) {
+	cmd := &cobra.Command{}
+	flags := commands.MovieCommandFlags{}
+	flags.Register(cmd, true)
+
+	if cert := flags.ResolveCertification(cmd, config.Config{}); cert != "" {
+		t.Errorf("Expected no certification filter by default, got '%s'", cert)
+	}
+	if cert := flags.ResolveCertification(cmd, config.Config{KidsMode: true}); cert != config.DefaultKidsCertification {
+		t.Errorf("Expected the kids mode default, got '%s'", cert)
+	}
+	if cert := flags.ResolveCertification(cmd, config.Config{KidsMode: true, MaxCertification: "6"}); cert != "6" {
+		t.Errorf("Expected the configured certification, got '%s'", cert)
+	}
+
+	cmd.Flags().Set("max-certification", "PG-13")
+	if cert := flags.ResolveCertification(cmd, config.Config{KidsMode: true}); cert != "PG-13" {
+		t.Errorf("Expected the flag to override kids mode, got '%s'", cert)
+	}
+}
+
+func TestMovieCommandFlagsFilterConfigCollectAll(t *testing.T) {
+	cfg := config.Config{Region: "DE", Scoring: "raw", PriorMean: 6.5, PriorVotes: 500}
+
+	tests := []struct {
+		flag     string
+		value    string
+		expected bool
+	}{
+		{"", "", false},
+		{"sort", "rating", true},
+		{"fits", "2h", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.flag, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			flags := commands.MovieCommandFlags{}
+			flags.Register(cmd, true)
+			flags.RegisterFits(cmd)
+			flags.RegisterSort(cmd)
+			if tt.flag != "" {
+				cmd.Flags().Set(tt.flag, tt.value)
+			}
+
+			filterConfig, err := flags.FilterConfig(cmd, cfg, nil, nil, map[string]int{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if filterConfig.CollectAll != tt.expected {
+				t.Errorf("Expected CollectAll %v, got %v", tt.expected, filterConfig.CollectAll)
+			}
+		})
+	}
+}
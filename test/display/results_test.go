@@ -0,0 +1,66 @@
+package display_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+func TestDisplayedResultsAreRecorded(t *testing.T) {
+	display.ResetResults()
+	captureOutput(func() {
+		display.DisplayMovie(display.MovieDisplay{Number: 1, Title: "Big", Year: "(1988)", TmdbID: 2280})
+		display.DisplayMovie(display.MovieDisplay{Number: 2, Title: "Cast Away", Year: "(2000)", TmdbID: 8358})
+		display.DisplayShow(display.ShowDisplay{Number: 1, Title: "Band of Brothers", Year: "(2001)", TmdbID: 4613})
+	})
+
+	results := display.LastResults()
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+	if results[0] != (display.Result{Number: 1, MediaType: models.MediaTypeMovie, ID: 2280, Title: "Big", Year: "1988"}) {
+		t.Errorf("Unexpected first result %+v", results[0])
+	}
+
+	if matches := display.ResultsByNumber(results, 2, ""); len(matches) != 1 || matches[0].ID != 8358 {
+		t.Errorf("Expected result 2 to be Cast Away, got %+v", matches)
+	}
+	if matches := display.ResultsByNumber(results, 1, ""); len(matches) != 2 {
+		t.Errorf("Expected number 1 to match a movie and a show, got %+v", matches)
+	}
+	if matches := display.ResultsByNumber(results, 1, models.MediaTypeTV); len(matches) != 1 || matches[0].ID != 4613 {
+		t.Errorf("Expected the show numbered 1, got %+v", matches)
+	}
+
+	display.ResetResults()
+	if len(display.LastResults()) != 0 {
+		t.Error("Expected no results after a reset")
+	}
+}
+
+func TestDisplayListItem(t *testing.T) {
+	display.ResetResults()
+	output := captureOutput(func() {
+		display.DisplayListItem(display.ListItemDisplay{
+			Number:    2,
+			MediaType: models.MediaTypeTV,
+			Title:     "The Wire",
+			Year:      "2002",
+			TmdbID:    1438,
+			Checked:   true,
+			Available: []string{"Netflix"},
+			Elsewhere: []string{"Apple TV Plus"},
+		})
+	})
+
+	for _, want := range []string{"2.", "The Wire", "(2002)", "Netflix", "Also on:", "Apple TV Plus", "themoviedb.org/tv/1438"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+	if results := display.LastResults(); len(results) != 1 || results[0].ID != 1438 || results[0].MediaType != models.MediaTypeTV {
+		t.Errorf("Expected the item to be recorded as a result, got %+v", results)
+	}
+}
@@ -0,0 +1,50 @@
+package display_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+func TestRenderTimeline(t *testing.T) {
+	best := models.Movie{ID: 1, Title: "The Dark Knight", ReleaseDate: "2008-07-16", VoteAverage: 8.5}
+	streaming := models.Movie{ID: 2, Title: "Batman Begins", ReleaseDate: "2005-06-10", VoteAverage: 7.7}
+	other := models.Movie{ID: 3, Title: "Insomnia", ReleaseDate: "2002-05-24", VoteAverage: 6.9}
+
+	output := display.RenderTimeline(display.TimelineDisplay{
+		Name: "Christian Bale",
+		Years: []filters.TimelineYear{
+			{Year: 2002, AverageRating: 6.9, Films: []models.Movie{other}},
+			{Year: 2005, AverageRating: 7.7, Films: []models.Movie{streaming}},
+			{Year: 2008, AverageRating: 8.5, Films: []models.Movie{best}},
+		},
+		Available: map[int]bool{2: true},
+		Top:       []models.Movie{best},
+	})
+
+	lines := strings.Split(output, "\n")
+	if !strings.HasPrefix(lines[0], "  8.5 ┤      ★") {
+		t.Errorf("Expected the top film in the first row, got %q", lines[0])
+	}
+	if !strings.Contains(output, "  7.5 ┤   ●") {
+		t.Errorf("Expected the available film in the 7.5 row, got:\n%s", output)
+	}
+	if !strings.Contains(output, "  7.0 ┤○") {
+		t.Errorf("Expected the other film in the 7.0 row, got:\n%s", output)
+	}
+	if !strings.Contains(output, "       2002") {
+		t.Errorf("Expected the first year as label, got:\n%s", output)
+	}
+	if !strings.Contains(output, "1. The Dark Knight (2008)") {
+		t.Errorf("Expected the top film to be listed, got:\n%s", output)
+	}
+}
+
+func TestRenderTimelineEmpty(t *testing.T) {
+	if output := display.RenderTimeline(display.TimelineDisplay{Name: "Nobody"}); !strings.Contains(output, "No rated films") {
+		t.Errorf("Expected a no-films message, got %q", output)
+	}
+}
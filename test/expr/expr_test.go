@@ -0,0 +1,142 @@
+package expr
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/expr"
+)
+
+func sampleRecord() expr.Record {
+	return expr.Record{
+		"title":      expr.String("Groundhog Day"),
+		"type":       expr.String("movie"),
+		"rating":     expr.Number(8.0),
+		"votes":      expr.Number(7200),
+		"year":       expr.Number(1993),
+		"date":       expr.String("1993-02-11"),
+		"runtime":    expr.Number(101),
+		"genres":     expr.Strings([]string{"Romance", "Fantasy", "Comedy"}),
+		"providers":  expr.Strings([]string{"Netflix", "Amazon Prime Video"}),
+		"popularity": expr.Number(25.3),
+	}
+}
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{"Full example", `rating >= 7 && votes > 500 && year in 1990..1999 && "Comedy" in genres && provider == "Netflix"`, true},
+		{"Rating too low", `rating > 8`, false},
+		{"Or", `rating > 9 || votes >= 7200`, true},
+		{"Keywords", `rating > 9 or not (year < 1990)`, true},
+		{"Negation", `!("Horror" in genres)`, true},
+		{"Range excludes", `year in 2000..2009`, false},
+		{"Range bounds inclusive", `year in 1993..1993`, true},
+		{"List literal", `year in [1993, 1994]`, true},
+		{"String list literal", `type in ["movie", "tv"]`, true},
+		{"Case-insensitive equality", `title == "groundhog day"`, true},
+		{"Contains substring", `title contains "hog"`, true},
+		{"Contains list element", `genres contains "fantasy"`, true},
+		{"List not equal", `provider != "Disney Plus"`, true},
+		{"String ordering", `date >= "1990-01-01" && date < "2000-01-01"`, true},
+		{"Precedence and binds tighter", `rating > 9 && votes > 0 || runtime <= 120`, true},
+		{"Parentheses", `rating > 9 && (votes > 0 || runtime <= 120)`, false},
+		{"Decimal", `popularity < 25.5`, true},
+	}
+
+	record := sampleRecord()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := expr.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result := expression.Eval(record); result != tt.expected {
+				t.Errorf("Eval(%s): expected %v, got %v", tt.input, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestEvalMissingField(t *testing.T) {
+	expression, err := expr.Parse(`runtime > 0`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expression.Eval(expr.Record{}) {
+		t.Error("Expected missing runtime to evaluate as 0")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		column  int
+		message string
+	}{
+		{"Unknown field", `rating >= 7 && score > 5`, 16, "unknown field"},
+		{"Unterminated string", `title == "Heat`, 10, "unterminated"},
+		{"Missing operand", `rating >=`, 10, "expected a value"},
+		{"Type mismatch", `rating == "high"`, 8, "cannot compare"},
+		{"Range on string", `title in 1990..1999`, 1, "range"},
+		{"Not a condition", `rating`, 1, "must be a condition"},
+		{"Trailing token", `rating > 7 votes`, 12, "unexpected"},
+		{"Unclosed parenthesis", `(rating > 7`, 12, "expected ')'"},
+		{"Unexpected character", `rating > 7 # comment`, 12, ""},
+		{"Empty", ``, 1, "expected a value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := expr.Parse(tt.input)
+			if err == nil {
+				t.Fatalf("expected an error for %q", tt.input)
+			}
+
+			var parseErr *expr.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("expected a ParseError, got %T", err)
+			}
+			if parseErr.Column != tt.column {
+				t.Errorf("expected column %d, got %d (%v)", tt.column, parseErr.Column, err)
+			}
+			if !strings.Contains(parseErr.Message, tt.message) {
+				t.Errorf("expected message containing %q, got %q", tt.message, parseErr.Message)
+			}
+		})
+	}
+}
+
+func TestFormatError(t *testing.T) {
+	input := `rating >= 7 && score > 5`
+	_, err := expr.Parse(input)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	lines := strings.Split(expr.FormatError(input, err), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(lines))
+	}
+	if strings.Index(lines[2], "^") != strings.Index(lines[1], "score") {
+		t.Errorf("caret is not under the offending token:\n%s\n%s", lines[1], lines[2])
+	}
+}
+
+func TestUses(t *testing.T) {
+	expression, err := expr.Parse(`runtime < 100 || provider == "Netflix"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !expression.Uses("runtime") || !expression.Uses("providers") {
+		t.Error("Expected runtime and providers (via alias) to be used")
+	}
+	if expression.Uses("rating") {
+		t.Error("Expected rating not to be used")
+	}
+}
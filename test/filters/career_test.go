@@ -0,0 +1,41 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+func TestComputeCareerStats(t *testing.T) {
+	movies := []models.Movie{
+		{ID: 1, Title: "Memento", ReleaseDate: "2000-10-11", VoteAverage: 8.2, VoteCount: 10000},
+		{ID: 2, Title: "Following", ReleaseDate: "1998-09-12", VoteAverage: 7.2, VoteCount: 1200},
+		{ID: 3, Title: "Doodlebug", ReleaseDate: "1997-01-01", VoteAverage: 9.5, VoteCount: 10},
+		{ID: 4, Title: "The Dark Knight", ReleaseDate: "2008-07-16", VoteAverage: 8.5, VoteCount: 30000},
+		{ID: 5, Title: "Untitled", ReleaseDate: ""},
+	}
+
+	stats := filters.ComputeCareerStats(movies)
+
+	if stats.Films != 5 {
+		t.Errorf("Expected 5 films, got %d", stats.Films)
+	}
+	if stats.RatedFilms != 3 {
+		t.Errorf("Expected 3 rated films, got %d", stats.RatedFilms)
+	}
+	if stats.AverageRating < 7.96 || stats.AverageRating > 7.97 {
+		t.Errorf("Expected an average of 7.97, got %.2f", stats.AverageRating)
+	}
+	if stats.Best == nil || stats.Best.ID != 4 {
+		t.Errorf("Expected The Dark Knight as best rated film, got %+v", stats.Best)
+	}
+	if stats.FirstYear != 1997 || stats.LastYear != 2008 {
+		t.Errorf("Expected active 1997-2008, got %d-%d", stats.FirstYear, stats.LastYear)
+	}
+
+	empty := filters.ComputeCareerStats(nil)
+	if empty.Films != 0 || empty.Best != nil || empty.FirstYear != 0 {
+		t.Errorf("Expected empty stats, got %+v", empty)
+	}
+}
@@ -0,0 +1,62 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+)
+
+func TestParseMaxCertification(t *testing.T) {
+	tests := []struct {
+		region    string
+		value     string
+		expected  int
+		expectErr bool
+	}{
+		{"DE", "12", 12, false},
+		{"US", "PG-13", 13, false},
+		{"US", "pg", 10, false},
+		{"US", "12", 12, false},
+		{"DE", "PG-13", 0, true},
+		{"DE", "-1", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.region+" "+tt.value, func(t *testing.T) {
+			age, err := filters.ParseMaxCertification(tt.region, tt.value)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %d", age)
+				}
+				return
+			}
+			if err != nil || age != tt.expected {
+				t.Errorf("Expected %d, got %d (%v)", tt.expected, age, err)
+			}
+		})
+	}
+}
+
+func TestMeetsCertification(t *testing.T) {
+	tests := []struct {
+		name          string
+		region        string
+		certification string
+		maxAge        int
+		expected      bool
+	}{
+		{"FSK 12 allowed", "DE", "12", 12, true},
+		{"FSK 16 too high", "DE", "16", 12, false},
+		{"PG allowed for 12", "US", "PG", 12, true},
+		{"PG-13 too high for 12", "US", "PG-13", 12, false},
+		{"Unrated excluded", "DE", "", 18, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := filters.MeetsCertification(tt.region, tt.certification, tt.maxAge); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package filters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+func TestMatchesCompanies(t *testing.T) {
+	a24 := models.Company{ID: 41077, Name: "A24"}
+	ghibli := models.Company{ID: 10342, Name: "Studio Ghibli"}
+
+	tests := []struct {
+		name      string
+		companies []models.Company
+		wanted    []models.Company
+		expected  bool
+	}{
+		{"No filter", []models.Company{{ID: 1}}, nil, true},
+		{"Produced by a wanted company", []models.Company{{ID: 1}, a24}, []models.Company{a24, ghibli}, true},
+		{"Produced by other companies", []models.Company{{ID: 1}}, []models.Company{a24}, false},
+		{"No companies", nil, []models.Company{a24}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := filters.MatchesCompanies(tt.companies, tt.wanted); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestBestCompanyMatch(t *testing.T) {
+	results := []models.Company{{ID: 1, Name: "A24 Films"}, {ID: 41077, Name: "A24"}}
+
+	if company, err := filters.BestCompanyMatch("a24", results); err != nil || company.ID != 41077 {
+		t.Errorf("Expected the exact match, got %+v (%v)", company, err)
+	}
+	_, err := filters.BestCompanyMatch("A2", results)
+	if err == nil {
+		t.Fatal("Expected an error without an exact match")
+	}
+	if !strings.Contains(err.Error(), "A24 Films (1)") || !strings.Contains(err.Error(), "A24 (41077)") {
+		t.Errorf("Expected the candidates in the error, got %v", err)
+	}
+	if _, err := filters.BestCompanyMatch("A24", nil); err == nil {
+		t.Error("Expected an error without results")
+	}
+}
+
+func TestCompanyAndNetworkIDs(t *testing.T) {
+	companies := []models.Company{{ID: 41077, Name: "A24"}, {ID: 10342, Name: "Studio Ghibli"}}
+	if ids := filters.CompanyIDs(companies); ids != "41077|10342" {
+		t.Errorf("Expected '41077|10342', got '%s'", ids)
+	}
+	if names := filters.CompanyNames(companies); names != "A24, Studio Ghibli" {
+		t.Errorf("Expected 'A24, Studio Ghibli', got '%s'", names)
+	}
+
+	networks := []models.Network{{ID: 49, Name: "HBO"}}
+	if ids := filters.NetworkIDs(networks); ids != "49" {
+		t.Errorf("Expected '49', got '%s'", ids)
+	}
+	if !filters.MatchesNetworks(networks, networks) || filters.MatchesNetworks(nil, networks) {
+		t.Error("Unexpected network match result")
+	}
+}
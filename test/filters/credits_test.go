@@ -0,0 +1,177 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{"", "actor", false},
+		{"Director", "director", false},
+		{" composer ", "composer", false},
+		{"any", "any", false},
+		{"producer", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			role, err := filters.ParseRole(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if role != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, role)
+			}
+		})
+	}
+}
+
+func TestCreditsForRole(t *testing.T) {
+	credits := &models.ActorCreditsResponse{
+		Cast: []models.Movie{
+			{ID: 1, Title: "Unforgiven", Character: "Bill Munny"},
+			{ID: 2, Title: "Escape from Alcatraz", Character: "Frank Morris"},
+		},
+		Crew: []models.Movie{
+			{ID: 1, Title: "Unforgiven", Job: "Director", Department: "Directing"},
+			{ID: 1, Title: "Unforgiven", Job: "Producer", Department: "Production"},
+			{ID: 3, Title: "Mystic River", Job: "Director", Department: "Directing"},
+			{ID: 3, Title: "Mystic River", Job: "Original Music Composer", Department: "Sound"},
+			{ID: 4, Title: "Bird", Job: "Screenplay", Department: "Writing"},
+		},
+	}
+
+	tests := []struct {
+		role     string
+		expected []int
+	}{
+		{"actor", []int{1, 2}},
+		{"director", []int{1, 3}},
+		{"writer", []int{4}},
+		{"composer", []int{3}},
+		{"any", []int{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			movies := filters.CreditsForRole(credits, tt.role)
+			if len(movies) != len(tt.expected) {
+				t.Fatalf("Expected %d movies, got %d", len(tt.expected), len(movies))
+			}
+			for i, id := range tt.expected {
+				if movies[i].ID != id {
+					t.Errorf("Expected movie %d at %d, got %d", id, i, movies[i].ID)
+				}
+			}
+		})
+	}
+
+	merged := filters.CreditsForRole(credits, "any")
+	if merged[0].Character != "Bill Munny" || merged[0].Job != "Director, Producer" {
+		t.Errorf("Expected actor and crew credits to be merged, got %+v", merged[0])
+	}
+	if merged[2].Job != "Director, Original Music Composer" {
+		t.Errorf("Expected the jobs to be joined, got '%s'", merged[2].Job)
+	}
+}
+
+func TestSplitCredits(t *testing.T) {
+	credits := []models.Movie{
+		{ID: 1, Title: "Drive", MediaType: "movie"},
+		{ID: 1396, Name: "Breaking Bad", MediaType: "tv", GenreIDs: []int{18}},
+		{ID: 22980, Name: "Late Night", MediaType: "tv", GenreIDs: []int{10767, 35}},
+		{ID: 2, Title: "Argo"},
+	}
+
+	tests := []struct {
+		media  string
+		movies int
+		shows  int
+	}{
+		{"all", 2, 1},
+		{"movie", 2, 0},
+		{"tv", 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.media, func(t *testing.T) {
+			movies, shows := filters.SplitCredits(credits, tt.media)
+			if len(movies) != tt.movies || len(shows) != tt.shows {
+				t.Errorf("Expected %d movies and %d shows, got %d and %d", tt.movies, tt.shows, len(movies), len(shows))
+			}
+		})
+	}
+
+	if _, err := filters.ParseMedia("books"); err == nil {
+		t.Error("Expected an error for an invalid media")
+	}
+}
+
+func TestCreditsForRoleKeepsMediaApart(t *testing.T) {
+	credits := &models.ActorCreditsResponse{
+		Cast: []models.Movie{
+			{ID: 7, Title: "A Movie", MediaType: "movie"},
+			{ID: 7, Name: "A Show", MediaType: "tv", EpisodeCount: 3},
+			{ID: 7, Name: "A Show", MediaType: "tv", EpisodeCount: 10},
+		},
+	}
+
+	movies := filters.CreditsForRole(credits, "actor")
+	if len(movies) != 2 {
+		t.Fatalf("Expected a movie and a show with the same ID, got %d credits", len(movies))
+	}
+	if movies[1].EpisodeCount != 10 {
+		t.Errorf("Expected the larger episode count, got %d", movies[1].EpisodeCount)
+	}
+}
+
+func TestSharedCredits(t *testing.T) {
+	hanks := []models.Movie{
+		{ID: 858, Title: "Sleepless in Seattle", MediaType: "movie", Character: "Sam Baldwin"},
+		{ID: 13, Title: "Forrest Gump", MediaType: "movie", Character: "Forrest Gump"},
+		{ID: 9489, Title: "You've Got Mail", MediaType: "movie", Character: "Joe Fox"},
+		{ID: 100, Name: "A Show", MediaType: "tv", Character: "Himself"},
+	}
+	ryan := []models.Movie{
+		{ID: 9489, Title: "You've Got Mail", MediaType: "movie", Character: "Kathleen Kelly"},
+		{ID: 858, Title: "Sleepless in Seattle", MediaType: "movie", Character: "Annie Reed"},
+		{ID: 100, Title: "Another Movie", MediaType: "movie", Character: "Jane"},
+	}
+	ephron := []models.Movie{
+		{ID: 858, Title: "Sleepless in Seattle", MediaType: "movie", Job: "Director, Screenplay"},
+		{ID: 9489, Title: "You've Got Mail", MediaType: "movie", Job: "Director"},
+	}
+
+	shared := filters.SharedCredits([][]models.Movie{hanks, ryan, ephron})
+	if len(shared) != 2 {
+		t.Fatalf("Expected 2 shared titles, got %d", len(shared))
+	}
+	if shared[0].Title.ID != 858 || shared[1].Title.ID != 9489 {
+		t.Errorf("Expected the order of the first filmography, got %d and %d", shared[0].Title.ID, shared[1].Title.ID)
+	}
+
+	roles := make([]string, len(shared[0].Credits))
+	for i, credit := range shared[0].Credits {
+		roles[i] = filters.CreditDescription(credit)
+	}
+	expected := []string{"Sam Baldwin", "Annie Reed", "Director, Screenplay"}
+	for i := range expected {
+		if roles[i] != expected[i] {
+			t.Errorf("Expected role '%s', got '%s'", expected[i], roles[i])
+		}
+	}
+
+	if description := filters.CreditDescription(models.Movie{Character: "Bill Munny", Job: "Director"}); description != "Bill Munny; Director" {
+		t.Errorf("Expected 'Bill Munny; Director', got '%s'", description)
+	}
+	if filters.SharedCredits(nil) != nil {
+		t.Error("Expected no shared credits without filmographies")
+	}
+}
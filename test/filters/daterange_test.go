@@ -0,0 +1,86 @@
+package filters_test
+
+import (
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+)
+
+func TestParseDateRange(t *testing.T) {
+	tests := []struct {
+		name         string
+		year         int
+		from         string
+		to           string
+		decade       string
+		expectedFrom string
+		expectedTo   string
+		shouldError  bool
+	}{
+		{"No filters", 0, "", "", "", "", "", false},
+		{"Year", 1994, "", "", "", "1994-01-01", "1994-12-31", false},
+		{"From year", 0, "2018", "", "", "2018-01-01", "", false},
+		{"To year", 0, "", "1999", "", "", "1999-12-31", false},
+		{"Full dates", 0, "2018-05-01", "2019-02-28", "", "2018-05-01", "2019-02-28", false},
+		{"Decade", 0, "", "", "1990s", "1990-01-01", "1999-12-31", false},
+		{"Short decade", 0, "", "", "80s", "1980-01-01", "1989-12-31", false},
+		{"Short decade this century", 0, "", "", "10s", "2010-01-01", "2019-12-31", false},
+		{"Decade and from intersect", 0, "1995", "", "1990s", "1995-01-01", "1999-12-31", false},
+		{"Invalid from", 0, "yesterday", "", "", "", "", true},
+		{"Invalid decade", 0, "", "", "1995s", "", "", true},
+		{"Empty range", 0, "2000", "1990", "", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := filters.ParseDateRange(tt.year, tt.from, tt.to, tt.decade)
+			if tt.shouldError {
+				if err == nil {
+					t.Errorf("Expected error, got range %s", r)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if r.FromString() != tt.expectedFrom {
+				t.Errorf("Expected from '%s', got '%s'", tt.expectedFrom, r.FromString())
+			}
+			if r.ToString() != tt.expectedTo {
+				t.Errorf("Expected to '%s', got '%s'", tt.expectedTo, r.ToString())
+			}
+		})
+	}
+}
+
+func TestDateRangeContains(t *testing.T) {
+	r, err := filters.ParseDateRange(0, "", "", "1990s")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		date     string
+		expected bool
+	}{
+		{"1990-01-01", true},
+		{"1994-07-06", true},
+		{"1999-12-31", true},
+		{"2000-01-01", false},
+		{"1989-12-31", false},
+		{"", false},
+		{"1994-07-06T00:00:00.000Z", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			if r.Contains(tt.date) != tt.expected {
+				t.Errorf("Expected Contains(%q) to be %v", tt.date, tt.expected)
+			}
+		})
+	}
+
+	if !(filters.DateRange{}).Contains("") {
+		t.Error("An empty range should match unknown dates")
+	}
+}
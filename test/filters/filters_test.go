@@ -0,0 +1,76 @@
+package filters_test
+
+import (
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+func TestFilterMultiSearchResults(t *testing.T) {
+	results := []models.MultiSearchResult{
+		{ID: 1, MediaType: models.MediaTypeMovie, Title: "The Matrix", ReleaseDate: "1999-03-31"},
+		{ID: 2, MediaType: models.MediaTypeTV, Name: "Matrix", FirstAirDate: "1993-03-01"},
+		{ID: 3, MediaType: models.MediaTypePerson, Name: "Matrix Person"},
+		{ID: 4, MediaType: models.MediaTypeMovie, Title: "The Matrix Reloaded", ReleaseDate: "2003-05-15"},
+	}
+
+	tests := []struct {
+		name        string
+		mediaType   string
+		year        int
+		expectedIDs []int
+	}{
+		{"No filters", "", 0, []int{1, 2, 3, 4}},
+		{"Movies only", models.MediaTypeMovie, 0, []int{1, 4}},
+		{"Shows only", models.MediaTypeTV, 0, []int{2}},
+		{"People only", models.MediaTypePerson, 0, []int{3}},
+		{"Year keeps people", "", 1999, []int{1, 3}},
+		{"Movies by year", models.MediaTypeMovie, 2003, []int{4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dateRange, err := filters.ParseDateRange(tt.year, "", "", "")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			filtered := filters.FilterMultiSearchResults(results, tt.mediaType, dateRange)
+			if len(filtered) != len(tt.expectedIDs) {
+				t.Fatalf("Expected %d results, got %d", len(tt.expectedIDs), len(filtered))
+			}
+			for i, id := range tt.expectedIDs {
+				if filtered[i].ID != id {
+					t.Errorf("Expected result %d to have ID %d, got %d", i, id, filtered[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestProviderIDs(t *testing.T) {
+	providers := []models.Provider{
+		{ProviderID: 8, ProviderName: "Netflix"},
+		{ProviderID: 9, ProviderName: "Amazon Prime Video"},
+		{ProviderID: 337, ProviderName: "Disney Plus"},
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Single provider", "Netflix", "8"},
+		{"Amazon alias", "netflix,amazon", "8|9"},
+		{"Unknown provider", "Sky", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := filters.ProviderIDs(providers, filters.ParseProviders(tt.input))
+			if result != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+}
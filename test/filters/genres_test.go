@@ -0,0 +1,141 @@
+package filters_test
+
+import (
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+var testGenreMap = filters.BuildGenreMap([]models.Genre{
+	{ID: 28, Name: "Action"},
+	{ID: 53, Name: "Thriller"},
+	{ID: 18, Name: "Drama"},
+	{ID: 10749, Name: "Romance"},
+	{ID: 27, Name: "Horror"},
+	{ID: 16, Name: "Animation"},
+})
+
+func TestParseGenreFilterMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		include  string
+		exclude  string
+		genreIDs []int
+		expected bool
+	}{
+		{"No filter", "", "", []int{27}, true},
+		{"Any of matches first", "Action,Thriller", "", []int{28}, true},
+		{"Any of matches second", "Action,Thriller", "", []int{53, 18}, true},
+		{"Any of no match", "Action,Thriller", "", []int{18}, false},
+		{"All of matches", "Drama+Romance", "", []int{18, 10749, 35}, true},
+		{"All of partial", "Drama+Romance", "", []int{18}, false},
+		{"Exclude hits", "", "Horror,Animation", []int{28, 16}, false},
+		{"Exclude misses", "", "Horror,Animation", []int{28}, true},
+		{"Include and exclude", "action", "horror", []int{28, 27}, false},
+		{"Genre by ID", "28", "", []int{28}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := filters.ParseGenreFilter(tt.include, tt.exclude, testGenreMap)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if filter.Matches(tt.genreIDs) != tt.expected {
+				t.Errorf("Expected Matches(%v) to be %v", tt.genreIDs, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseGenreFilterErrors(t *testing.T) {
+	if _, err := filters.ParseGenreFilter("Western", "", testGenreMap); err == nil {
+		t.Error("Expected error for unknown genre")
+	}
+	if _, err := filters.ParseGenreFilter("Action,Drama+Romance", "", testGenreMap); err == nil {
+		t.Error("Expected error when mixing ',' and '+'")
+	}
+}
+
+func TestGenreFilterDiscoverSyntax(t *testing.T) {
+	anyOf, _ := filters.ParseGenreFilter("Action,Thriller", "Horror,Animation", testGenreMap)
+	if anyOf.WithGenres() != "28|53" {
+		t.Errorf("Expected '28|53', got '%s'", anyOf.WithGenres())
+	}
+	if anyOf.WithoutGenres() != "27,16" {
+		t.Errorf("Expected '27,16', got '%s'", anyOf.WithoutGenres())
+	}
+
+	allOf, _ := filters.ParseGenreFilter("Drama+Romance", "", testGenreMap)
+	if allOf.WithGenres() != "18,10749" {
+		t.Errorf("Expected '18,10749', got '%s'", allOf.WithGenres())
+	}
+}
+
+func TestParseGenreFilterTVGenreParts(t *testing.T) {
+	tvGenres := filters.BuildGenreMap([]models.Genre{
+		{ID: 10759, Name: "Action & Adventure"},
+		{ID: 10765, Name: "Sci-Fi & Fantasy"},
+	})
+
+	filter, err := filters.ParseGenreFilter("Action", "Fantasy", tvGenres)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if filter.WithGenres() != "10759" || filter.WithoutGenres() != "10765" {
+		t.Errorf("Expected TV genres to resolve by name part, got %s / %s", filter.WithGenres(), filter.WithoutGenres())
+	}
+}
+
+// Movie genre lists as returned by /genre/movie/list for en-US and de-DE
+var (
+	englishMovieGenres = []models.Genre{
+		{ID: 28, Name: "Action"}, {ID: 12, Name: "Adventure"}, {ID: 16, Name: "Animation"},
+		{ID: 35, Name: "Comedy"}, {ID: 80, Name: "Crime"}, {ID: 99, Name: "Documentary"},
+		{ID: 18, Name: "Drama"}, {ID: 10751, Name: "Family"}, {ID: 14, Name: "Fantasy"},
+		{ID: 36, Name: "History"}, {ID: 27, Name: "Horror"}, {ID: 10402, Name: "Music"},
+		{ID: 9648, Name: "Mystery"}, {ID: 10749, Name: "Romance"}, {ID: 878, Name: "Science Fiction"},
+		{ID: 10770, Name: "TV Movie"}, {ID: 53, Name: "Thriller"}, {ID: 10752, Name: "War"},
+		{ID: 37, Name: "Western"},
+	}
+	germanMovieGenres = []models.Genre{
+		{ID: 28, Name: "Action"}, {ID: 12, Name: "Abenteuer"}, {ID: 16, Name: "Animation"},
+		{ID: 35, Name: "Komödie"}, {ID: 80, Name: "Krimi"}, {ID: 99, Name: "Dokumentarfilm"},
+		{ID: 18, Name: "Drama"}, {ID: 10751, Name: "Familie"}, {ID: 14, Name: "Fantasy"},
+		{ID: 36, Name: "Historie"}, {ID: 27, Name: "Horror"}, {ID: 10402, Name: "Musik"},
+		{ID: 9648, Name: "Mystery"}, {ID: 10749, Name: "Liebesfilm"}, {ID: 878, Name: "Science Fiction"},
+		{ID: 10770, Name: "TV-Film"}, {ID: 53, Name: "Thriller"}, {ID: 10752, Name: "Kriegsfilm"},
+		{ID: 37, Name: "Western"},
+	}
+)
+
+func TestParseGenreFilterEnglishAndGermanNames(t *testing.T) {
+	genreMap := filters.BuildGenreMap(germanMovieGenres, englishMovieGenres)
+
+	tests := []struct {
+		include  string
+		expected string
+	}{
+		{"Drama+Romance", "18,10749"},
+		{"Drama+Liebesfilm", "18,10749"},
+		{"Comedy,Krimi", "35|80"},
+		{"komödie", "35"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.include, func(t *testing.T) {
+			filter, err := filters.ParseGenreFilter(tt.include, "", genreMap)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if filter.WithGenres() != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, filter.WithGenres())
+			}
+		})
+	}
+
+	if _, err := filters.ParseGenreFilter("Drama+Romance", "", filters.BuildGenreMap(germanMovieGenres)); err == nil {
+		t.Error("Expected 'Romance' to be unknown in the German list alone")
+	}
+}
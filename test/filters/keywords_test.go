@@ -0,0 +1,92 @@
+package filters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+var (
+	heist      = models.Keyword{ID: 10051, Name: "heist"}
+	timeTravel = models.Keyword{ID: 4379, Name: "time travel"}
+	novel      = models.Keyword{ID: 818, Name: "based on novel or book"}
+)
+
+func TestKeywordFilterMatches(t *testing.T) {
+	filter := filters.KeywordFilter{
+		Include: []models.Keyword{heist, timeTravel},
+		Exclude: []models.Keyword{novel},
+	}
+
+	tests := []struct {
+		name       string
+		keywordIDs []int
+		expected   bool
+	}{
+		{"Has an included keyword", []int{1, heist.ID}, true},
+		{"Has another included keyword", []int{timeTravel.ID}, true},
+		{"Has an excluded keyword", []int{heist.ID, novel.ID}, false},
+		{"Has no included keyword", []int{1, 2}, false},
+		{"No keywords", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := filter.Matches(tt.keywordIDs); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	excludeOnly := filters.KeywordFilter{Exclude: []models.Keyword{novel}}
+	if !excludeOnly.Matches(nil) {
+		t.Error("Expected an exclude-only filter to match titles without keywords")
+	}
+}
+
+func TestKeywordFilterDiscoverSyntax(t *testing.T) {
+	filter := filters.KeywordFilter{
+		Include: []models.Keyword{heist, timeTravel},
+		Exclude: []models.Keyword{novel},
+	}
+
+	if with := filter.WithKeywords(); with != "10051|4379" {
+		t.Errorf("Expected '10051|4379', got '%s'", with)
+	}
+	if without := filter.WithoutKeywords(); without != "818" {
+		t.Errorf("Expected '818', got '%s'", without)
+	}
+	if description := filter.String(); description != "heist or time travel, not based on novel or book" {
+		t.Errorf("Unexpected description '%s'", description)
+	}
+	if !(filters.KeywordFilter{}).IsZero() || filter.IsZero() {
+		t.Error("IsZero reports the wrong state")
+	}
+}
+
+func TestBestKeywordMatch(t *testing.T) {
+	results := []models.Keyword{{ID: 1, Name: "heist movie"}, heist}
+
+	if keyword, err := filters.BestKeywordMatch("Heist", results); err != nil || keyword.ID != heist.ID {
+		t.Errorf("Expected the exact match, got %+v (%v)", keyword, err)
+	}
+	_, err := filters.BestKeywordMatch("heis", results)
+	if err == nil {
+		t.Fatal("Expected an error without an exact match")
+	}
+	if !strings.Contains(err.Error(), "heist movie (1)") || !strings.Contains(err.Error(), "heist (10051)") {
+		t.Errorf("Expected the candidates in the error, got %v", err)
+	}
+	if _, err := filters.BestKeywordMatch("heist", nil); err == nil {
+		t.Error("Expected an error without results")
+	}
+}
+
+func TestSplitNames(t *testing.T) {
+	names := filters.SplitNames(" heist, time travel ,,")
+	if len(names) != 2 || names[0] != "heist" || names[1] != "time travel" {
+		t.Errorf("Expected [heist time travel], got %v", names)
+	}
+}
@@ -0,0 +1,86 @@
+package filters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+)
+
+func TestParseLanguageCodes(t *testing.T) {
+	codes, err := filters.ParseLanguageCodes(" KO, ja ,")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(codes, []string{"ko", "ja"}) {
+		t.Errorf("Expected [ko ja], got %v", codes)
+	}
+
+	if _, err := filters.ParseLanguageCodes("korean"); err == nil {
+		t.Error("Expected an error for a language name instead of a code")
+	}
+}
+
+func TestParseCountryCodes(t *testing.T) {
+	codes, err := filters.ParseCountryCodes("kr,SE")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(codes, []string{"KR", "SE"}) {
+		t.Errorf("Expected [KR SE], got %v", codes)
+	}
+
+	if _, err := filters.ParseCountryCodes("K1"); err == nil {
+		t.Error("Expected an error for an invalid country code")
+	}
+
+	codes, err = filters.ParseCountryCodes("")
+	if err != nil || len(codes) != 0 {
+		t.Errorf("Expected no codes and no error for empty input, got %v, %v", codes, err)
+	}
+}
+
+func TestMatchesLanguage(t *testing.T) {
+	tests := []struct {
+		name      string
+		language  string
+		languages []string
+		expected  bool
+	}{
+		{"No filter", "en", nil, true},
+		{"Match", "ko", []string{"ko", "ja"}, true},
+		{"No match", "en", []string{"ko", "ja"}, false},
+		{"Unknown language", "", []string{"ko"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := filters.MatchesLanguage(tt.language, tt.languages); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMatchesCountry(t *testing.T) {
+	tests := []struct {
+		name      string
+		countries []string
+		codes     []string
+		expected  bool
+	}{
+		{"No filter", nil, nil, true},
+		{"Any country matches", []string{"DK", "SE"}, []string{"SE", "NO"}, true},
+		{"Case-insensitive", []string{"kr"}, []string{"KR"}, true},
+		{"No match", []string{"US"}, []string{"KR"}, false},
+		{"Unknown countries", nil, []string{"KR"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := filters.MatchesCountry(tt.countries, tt.codes); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package filters_test
+
+import (
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+func TestMeetsRuntimeCriteria(t *testing.T) {
+	tests := []struct {
+		name       string
+		runtime    int
+		minRuntime int
+		maxRuntime int
+		expected   bool
+	}{
+		{"No bounds", 0, 0, 0, true},
+		{"Unknown runtime with bound", 0, 0, 120, false},
+		{"Below max", 100, 0, 120, true},
+		{"Exactly max", 120, 0, 120, true},
+		{"Above max", 121, 0, 120, false},
+		{"Below min", 80, 90, 0, false},
+		{"Within both", 95, 90, 120, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := filters.MeetsRuntimeCriteria(tt.runtime, tt.minRuntime, tt.maxRuntime)
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseTimeBudget(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    int
+		shouldError bool
+	}{
+		{"", 0, false},
+		{"2h30m", 150, false},
+		{"90m", 90, false},
+		{"3h", 180, false},
+		{"150", 150, false},
+		{"0", 0, true},
+		{"30s", 0, true},
+		{"evening", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := filters.ParseTimeBudget(tt.input)
+			if tt.shouldError {
+				if err == nil {
+					t.Errorf("Expected error for %q", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %d minutes, got %d", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestSelectWithinBudget(t *testing.T) {
+	movies := []models.Movie{
+		{ID: 1, VoteAverage: 7.5, Runtime: 90},
+		{ID: 2, VoteAverage: 8.8, Runtime: 142},
+		{ID: 3, VoteAverage: 8.2, Runtime: 100},
+		{ID: 4, VoteAverage: 9.0, Runtime: 0},
+		{ID: 5, VoteAverage: 7.9, Runtime: 60},
+	}
+
+	selected := filters.SelectWithinBudget(movies, 240, filters.Scoring{})
+
+	// 8.8 (142) fits, 8.2 (100) doesn't, 7.9 (60) fits, 7.5 (90) doesn't; unknown runtime is skipped
+	expectedIDs := []int{2, 5}
+	if len(selected) != len(expectedIDs) {
+		t.Fatalf("Expected %d movies, got %d", len(expectedIDs), len(selected))
+	}
+	for i, id := range expectedIDs {
+		if movies[selected[i]].ID != id {
+			t.Errorf("Expected movie %d at position %d, got %d", id, i, movies[selected[i]].ID)
+		}
+	}
+}
+
+func TestSelectWithinBudgetWeighted(t *testing.T) {
+	movies := []models.Movie{
+		{ID: 1, VoteAverage: 9.5, VoteCount: 10, Runtime: 120},
+		{ID: 2, VoteAverage: 8.0, VoteCount: 20000, Runtime: 120},
+	}
+	scoring := filters.Scoring{Weighted: true, PriorMean: 6.5, PriorVotes: 500}
+
+	// With few votes the 9.5 is shrunk toward the prior, so the 8.0 is picked
+	selected := filters.SelectWithinBudget(movies, 150, scoring)
+	if len(selected) != 1 || movies[selected[0]].ID != 2 {
+		t.Errorf("Expected movie 2 to be picked in weighted mode, got %v", selected)
+	}
+
+	selected = filters.SelectWithinBudget(movies, 150, filters.Scoring{})
+	if len(selected) != 1 || movies[selected[0]].ID != 1 {
+		t.Errorf("Expected movie 1 to be picked in raw mode, got %v", selected)
+	}
+}
+
+func TestEpisodesWithinBudget(t *testing.T) {
+	if n := filters.EpisodesWithinBudget(45, 150); n != 3 {
+		t.Errorf("Expected 3 episodes, got %d", n)
+	}
+	if n := filters.EpisodesWithinBudget(0, 150); n != 0 {
+		t.Errorf("Expected 0 episodes for unknown runtime, got %d", n)
+	}
+}
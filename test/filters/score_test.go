@@ -0,0 +1,138 @@
+package filters
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+func TestWeightedRating(t *testing.T) {
+	scoring := filters.Scoring{PriorMean: 6.0, PriorVotes: 1000}
+
+	tests := []struct {
+		name     string
+		average  float64
+		votes    int
+		expected float64
+	}{
+		{"No votes falls back to the prior", 9.0, 0, 6.0},
+		{"Equal weight", 8.0, 1000, 7.0},
+		{"Many votes approach the average", 8.0, 99000, 7.98},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := scoring.WeightedRating(tt.average, tt.votes)
+			if math.Abs(result-tt.expected) > 0.001 {
+				t.Errorf("Expected %.3f, got %.3f", tt.expected, result)
+			}
+		})
+	}
+
+	noPrior := filters.Scoring{}
+	if result := noPrior.WeightedRating(7.2, 0); result != 7.2 {
+		t.Errorf("Expected the raw average without prior and votes, got %.2f", result)
+	}
+}
+
+func TestHiddenGemScore(t *testing.T) {
+	scoring := filters.Scoring{PriorMean: 6.5, PriorVotes: 500}
+
+	obscure := scoring.HiddenGemScore(8.0, 2000, 3)
+	mainstream := scoring.HiddenGemScore(8.0, 2000, 250)
+	if obscure <= mainstream {
+		t.Errorf("Expected the obscure title to score higher: %.2f vs %.2f", obscure, mainstream)
+	}
+
+	weighted := scoring.WeightedRating(8.0, 2000)
+	if math.Abs(scoring.HiddenGemScore(8.0, 2000, 0)-weighted) > 0.001 {
+		t.Error("Expected popularity 0 to keep the full weighted rating")
+	}
+	if math.Abs(mainstream-weighted/2) > 0.001 {
+		t.Error("Expected popularity above the ceiling to halve the weighted rating")
+	}
+
+	fewVotes := scoring.HiddenGemScore(9.5, 10, 3)
+	if fewVotes >= obscure {
+		t.Errorf("Expected a 9.5 with 10 votes to rank below an 8.0 with 2000 votes: %.2f vs %.2f", fewVotes, obscure)
+	}
+}
+
+func TestParseSortKey(t *testing.T) {
+	if key, err := filters.ParseSortKey(" Gem "); err != nil || key != "gem" {
+		t.Errorf("Expected 'gem', got %q, %v", key, err)
+	}
+	if key, err := filters.ParseSortKey(""); err != nil || key != "" {
+		t.Errorf("Expected empty key, got %q, %v", key, err)
+	}
+	if _, err := filters.ParseSortKey("budget"); err == nil {
+		t.Error("Expected an error for an unknown sort key")
+	}
+}
+
+func TestSortMovies(t *testing.T) {
+	movies := []models.Movie{
+		{Title: "Blockbuster", VoteAverage: 8.1, VoteCount: 30000, Popularity: 180, ReleaseDate: "2010-07-15"},
+		{Title: "Arthouse", VoteAverage: 7.9, VoteCount: 2500, Popularity: 4, ReleaseDate: "2016-03-01"},
+		{Title: "Cult", VoteAverage: 7.5, VoteCount: 800, Popularity: 9, ReleaseDate: "1998-11-20"},
+	}
+	scoring := filters.Scoring{PriorMean: 6.5, PriorVotes: 500}
+
+	tests := []struct {
+		key      string
+		expected []int
+	}{
+		{"", []int{0, 1, 2}},
+		{"rating", []int{0, 1, 2}},
+		{"votes", []int{0, 1, 2}},
+		{"date", []int{1, 0, 2}},
+		{"title", []int{1, 0, 2}},
+		{"gem", []int{1, 2, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			indices := []int{0, 1, 2}
+			filters.SortMovies(movies, indices, tt.key, scoring)
+			if !reflect.DeepEqual(indices, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, indices)
+			}
+		})
+	}
+}
+
+func TestParseScoringMode(t *testing.T) {
+	for mode, expected := range map[string]bool{"": false, "raw": false, "Weighted": true} {
+		weighted, err := filters.ParseScoringMode(mode)
+		if err != nil || weighted != expected {
+			t.Errorf("ParseScoringMode(%q): expected %v, got %v, %v", mode, expected, weighted, err)
+		}
+	}
+	if _, err := filters.ParseScoringMode("imdb"); err == nil {
+		t.Error("Expected an error for an unknown mode")
+	}
+}
+
+func TestScoringMeetsRatingCriteria(t *testing.T) {
+	raw := filters.Scoring{PriorMean: 6.5, PriorVotes: 500}
+	weighted := filters.Scoring{PriorMean: 6.5, PriorVotes: 500, Weighted: true}
+
+	// The hard cutoff drops an 8.4 with 990 votes but keeps a 7.5 with 1001
+	if raw.MeetsRatingCriteria(8.4, 990, 7.5, 1000) {
+		t.Error("Expected raw scoring to drop 8.4 with 990 votes")
+	}
+	if !raw.MeetsRatingCriteria(7.5, 1001, 7.5, 1000) {
+		t.Error("Expected raw scoring to keep 7.5 with 1001 votes")
+	}
+
+	// Weighted scoring ranks by evidence instead
+	if !weighted.MeetsRatingCriteria(8.4, 990, 7.5, 1000) {
+		t.Error("Expected weighted scoring to keep 8.4 with 990 votes")
+	}
+	if weighted.MeetsRatingCriteria(7.5, 1001, 7.5, 1000) {
+		t.Error("Expected weighted scoring to drop 7.5 with 1001 votes")
+	}
+}
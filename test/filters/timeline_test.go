@@ -0,0 +1,35 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/filters"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+func TestBuildTimeline(t *testing.T) {
+	movies := []models.Movie{
+		{ID: 1, ReleaseDate: "2008-07-16", VoteAverage: 8.5, VoteCount: 30000},
+		{ID: 2, ReleaseDate: "2000-10-11", VoteAverage: 8.2, VoteCount: 10000},
+		{ID: 3, ReleaseDate: "2008-01-01", VoteAverage: 6.5, VoteCount: 500},
+		{ID: 4, ReleaseDate: "2005-01-01", VoteAverage: 9.9, VoteCount: 3},
+		{ID: 5, ReleaseDate: "", VoteAverage: 7.0, VoteCount: 900},
+	}
+
+	timeline := filters.BuildTimeline(movies)
+
+	if len(timeline) != 2 {
+		t.Fatalf("Expected 2 years, got %d", len(timeline))
+	}
+	if timeline[0].Year != 2000 || timeline[1].Year != 2008 {
+		t.Errorf("Expected 2000 and 2008, got %d and %d", timeline[0].Year, timeline[1].Year)
+	}
+	if timeline[1].AverageRating != 7.5 || len(timeline[1].Films) != 2 {
+		t.Errorf("Expected 2 films averaging 7.5 in 2008, got %d averaging %.2f", len(timeline[1].Films), timeline[1].AverageRating)
+	}
+
+	top := filters.TopRatedFilms(timeline, 2)
+	if len(top) != 2 || top[0].ID != 1 || top[1].ID != 2 {
+		t.Errorf("Expected films 1 and 2 as top rated, got %+v", top)
+	}
+}
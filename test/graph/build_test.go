@@ -0,0 +1,205 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/graph"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// Kevin (1) made movies 10 and 11 with Person 2, movie 40 with Person 4
+var collaborations = network{
+	10: {1, 2},
+	11: {1, 2, 3},
+	40: {1, 4},
+	50: {4, 5},
+}
+
+func edgeWeight(g *graph.Graph, a, b string) int {
+	for _, edge := range g.Edges {
+		if (edge.From == a && edge.To == b) || (edge.From == b && edge.To == a) {
+			return edge.Weight
+		}
+	}
+	return 0
+}
+
+func TestBuildCostarGraph(t *testing.T) {
+	memo, _ := collaborations.memo(0)
+
+	g, err := graph.Build(memo, kevin, graph.BuildOptions{Depth: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(g.Nodes) != 4 {
+		t.Errorf("Expected Kevin and 3 co-stars, got %d nodes", len(g.Nodes))
+	}
+	if weight := edgeWeight(g, "p1", "p2"); weight != 2 {
+		t.Errorf("Expected 2 shared films of Kevin and Person 2, got %d", weight)
+	}
+	if weight := edgeWeight(g, "p2", "p3"); weight != 1 {
+		t.Errorf("Expected 1 shared film of Person 2 and 3, got %d", weight)
+	}
+	if weight := edgeWeight(g, "p4", "p5"); weight != 0 {
+		t.Errorf("Expected Person 5 to be beyond depth 1, got weight %d", weight)
+	}
+
+	memo, _ = collaborations.memo(0)
+	deep, err := graph.Build(memo, kevin, graph.BuildOptions{Depth: 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if weight := edgeWeight(deep, "p4", "p5"); weight != 1 {
+		t.Errorf("Expected Person 4 and 5 to be connected at depth 2, got weight %d", weight)
+	}
+	if weight := edgeWeight(deep, "p1", "p2"); weight != 2 {
+		t.Errorf("Expected shared films to be counted once, got weight %d", weight)
+	}
+}
+
+func TestBuildBipartiteGraphWithPruning(t *testing.T) {
+	memo, _ := collaborations.memo(0)
+
+	g, err := graph.Build(memo, kevin, graph.BuildOptions{
+		Depth:     1,
+		Bipartite: true,
+		Allow: func(movie models.Movie) (bool, error) {
+			return movie.ID != 40, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	kinds := map[string]int{}
+	for _, node := range g.Nodes {
+		kinds[node.Kind]++
+	}
+	if kinds[graph.KindPerson] != 3 || kinds[graph.KindMovie] != 2 {
+		t.Errorf("Expected 3 people and 2 movies, got %v", kinds)
+	}
+	if edgeWeight(g, "p1", "m11") != 1 || edgeWeight(g, "p1", "m40") != 0 {
+		t.Errorf("Expected Kevin linked to movie 11 but not the pruned movie 40, got %+v", g.Edges)
+	}
+}
+
+func TestBuildRootBilledBelowCutoff(t *testing.T) {
+	// Kevin (1) is billed third in movie 60 and second in movie 61
+	memo, _ := network{
+		60: {2, 3, 1},
+		61: {2, 1},
+	}.memo(0)
+
+	g, err := graph.Build(memo, kevin, graph.BuildOptions{Depth: 1, MaxCastPerMovie: 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if weight := edgeWeight(g, "p1", "p2"); weight != 2 {
+		t.Errorf("Expected 2 shared films of Kevin and Person 2, got %d", weight)
+	}
+	if weight := edgeWeight(g, "p1", "p3"); weight != 1 {
+		t.Errorf("Expected 1 shared film of Kevin and Person 3, got %d", weight)
+	}
+
+	memo, _ = network{60: {2, 3, 1}}.memo(0)
+	bipartite, err := graph.Build(memo, kevin, graph.BuildOptions{Depth: 1, Bipartite: true, MaxCastPerMovie: 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if edgeWeight(bipartite, "p1", "m60") != 1 {
+		t.Errorf("Expected Kevin linked to his movie, got %+v", bipartite.Edges)
+	}
+}
+
+func TestBuildTruncatedByBudget(t *testing.T) {
+	memo, _ := collaborations.memo(2)
+
+	g, err := graph.Build(memo, kevin, graph.BuildOptions{Depth: 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !g.Truncated || len(g.Nodes) == 0 {
+		t.Errorf("Expected a truncated graph, got %+v", g)
+	}
+}
+
+var exportGraph = &graph.Graph{
+	Nodes: []graph.Node{
+		{ID: "p1", Kind: graph.KindPerson, Label: `Kevin "K" Bacon`, TmdbID: 1},
+		{ID: "m10", Kind: graph.KindMovie, Label: "Tremors & Co", TmdbID: 10, Rating: 7.1, Year: "1990"},
+	},
+	Edges: []graph.Edge{{From: "m10", To: "p1", Weight: 3}},
+}
+
+func TestWriteDOT(t *testing.T) {
+	var buf bytes.Buffer
+	if err := graph.Write(&buf, exportGraph, "dot"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	output := buf.String()
+
+	for _, expected := range []string{
+		"graph tmdb {",
+		`"p1" [label="Kevin \"K\" Bacon"];`,
+		`"m10" [label="Tremors & Co (1990)", shape=box];`,
+		`"m10" -- "p1" [weight=3, label="3", penwidth=3];`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %q in:\n%s", expected, output)
+		}
+	}
+}
+
+func TestWriteGraphML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := graph.Write(&buf, exportGraph, "graphml"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var doc struct {
+		Graph struct {
+			Nodes []struct {
+				ID string `xml:"id,attr"`
+			} `xml:"node"`
+			Edges []struct {
+				Source string `xml:"source,attr"`
+			} `xml:"edge"`
+		} `xml:"graph"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("Expected valid XML, got %v", err)
+	}
+	if len(doc.Graph.Nodes) != 2 || len(doc.Graph.Edges) != 1 || doc.Graph.Edges[0].Source != "m10" {
+		t.Errorf("Unexpected GraphML: %+v", doc)
+	}
+	if !strings.Contains(buf.String(), "Tremors &amp; Co") {
+		t.Error("Expected labels to be escaped")
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := graph.Write(&buf, exportGraph, "JSON"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var doc struct {
+		Nodes []map[string]any `json:"nodes"`
+		Edges []map[string]any `json:"edges"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("Expected valid JSON, got %v", err)
+	}
+	if len(doc.Nodes) != 2 || doc.Edges[0]["weight"] != float64(3) {
+		t.Errorf("Unexpected JSON: %s", buf.String())
+	}
+
+	if _, err := graph.ParseFormat("svg"); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/graph"
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+// network is a small co-star graph: movie ID -> cast person IDs
+type network map[int][]int
+
+func (n network) memo(budget int) (*graph.Memo, *int) {
+	calls := 0
+	credits := func(personID int) ([]models.Movie, error) {
+		calls++
+		var movies []models.Movie
+		for movieID, cast := range n {
+			for _, id := range cast {
+				if id == personID {
+					movies = append(movies, models.Movie{ID: movieID, Title: fmt.Sprintf("Movie %d", movieID), ReleaseDate: "2000-01-01", Popularity: float64(movieID)})
+				}
+			}
+		}
+		return movies, nil
+	}
+	cast := func(movieID int) ([]models.CastMember, error) {
+		calls++
+		var members []models.CastMember
+		for i, id := range n[movieID] {
+			members = append(members, models.CastMember{ID: id, Name: fmt.Sprintf("Person %d", id), Order: i})
+		}
+		return members, nil
+	}
+	return graph.NewMemo(credits, cast, budget), &calls
+}
+
+// Kevin (1) and Nicolas (6) are connected via 1-2 (movie 10), 2-3 (movie 20), 3-6 (movie 30)
+// and via a longer chain 1-4 (movie 40), 4-5 (movie 50), 5-7 (movie 60), 7-6 (movie 70)
+var costars = network{
+	10: {1, 2},
+	20: {2, 3},
+	30: {3, 6},
+	40: {1, 4},
+	50: {4, 5},
+	60: {5, 7},
+	70: {7, 6},
+	80: {8, 9},
+}
+
+var (
+	kevin   = graph.Person{ID: 1, Name: "Kevin"}
+	nicolas = graph.Person{ID: 6, Name: "Nicolas"}
+)
+
+func TestFindPathShortest(t *testing.T) {
+	memo, _ := costars.memo(0)
+
+	path, found, err := graph.FindPath(memo, kevin, nicolas, graph.Options{MaxDepth: 6})
+	if err != nil || !found {
+		t.Fatalf("Expected a path, got found=%v err=%v", found, err)
+	}
+
+	expectedPeople := []int{1, 2, 3, 6}
+	expectedMovies := []int{10, 20, 30}
+	if len(path.People) != len(expectedPeople) || len(path.Movies) != len(expectedMovies) {
+		t.Fatalf("Expected %d people and %d movies, got %+v", len(expectedPeople), len(expectedMovies), path)
+	}
+	for i, id := range expectedPeople {
+		if path.People[i].ID != id {
+			t.Errorf("Expected person %d at %d, got %d", id, i, path.People[i].ID)
+		}
+	}
+	for i, id := range expectedMovies {
+		if path.Movies[i].ID != id {
+			t.Errorf("Expected movie %d at %d, got %d", id, i, path.Movies[i].ID)
+		}
+	}
+	if path.People[0].Name != "Kevin" || path.People[1].Name != "Person 2" || path.People[3].Name != "Nicolas" {
+		t.Errorf("Unexpected names: %+v", path.People)
+	}
+}
+
+func TestFindPathAllow(t *testing.T) {
+	memo, _ := costars.memo(0)
+
+	// Without movie 20 only the longer chain is left
+	opts := graph.Options{
+		MaxDepth: 6,
+		Allow: func(movie models.Movie) (bool, error) {
+			return movie.ID != 20, nil
+		},
+	}
+	path, found, err := graph.FindPath(memo, kevin, nicolas, opts)
+	if err != nil || !found {
+		t.Fatalf("Expected a path, got found=%v err=%v", found, err)
+	}
+	if len(path.Movies) != 4 || path.Movies[0].ID != 40 || path.Movies[3].ID != 70 {
+		t.Errorf("Expected the chain 40-50-60-70, got %+v", path.Movies)
+	}
+}
+
+func TestFindPathLimits(t *testing.T) {
+	memo, _ := costars.memo(0)
+	if _, found, err := graph.FindPath(memo, kevin, nicolas, graph.Options{MaxDepth: 2}); found || err != nil {
+		t.Errorf("Expected no path within 2 movies, got found=%v err=%v", found, err)
+	}
+
+	memo, _ = costars.memo(0)
+	if _, found, err := graph.FindPath(memo, kevin, graph.Person{ID: 8, Name: "Loner"}, graph.Options{MaxDepth: 6}); found || err != nil {
+		t.Errorf("Expected no path between unconnected people, got found=%v err=%v", found, err)
+	}
+
+	memo, _ = costars.memo(2)
+	if _, _, err := graph.FindPath(memo, kevin, nicolas, graph.Options{MaxDepth: 6}); !errors.Is(err, graph.ErrBudgetExceeded) {
+		t.Errorf("Expected the budget to be exceeded, got %v", err)
+	}
+}
+
+func TestMemoCachesLookups(t *testing.T) {
+	memo, calls := costars.memo(0)
+
+	for i := 0; i < 3; i++ {
+		if _, err := memo.Credits(1); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, err := memo.Cast(10); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if *calls != 2 || memo.Requests != 2 {
+		t.Errorf("Expected 2 lookups, got %d calls and %d requests", *calls, memo.Requests)
+	}
+}
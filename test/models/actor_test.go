@@ -1,7 +1,9 @@
 package models
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/sebastianneubert/tmdb/internal/models"
 )
@@ -88,3 +90,54 @@ func TestActorWithKnownFor(t *testing.T) {
 		t.Errorf("Expected known for title 'Famous Movie', got '%s'", actor.KnownFor[0].Title)
 	}
 }
+
+func TestCombinedCreditsDecoding(t *testing.T) {
+	data := `{"id": 17419, "cast": [
+		{"id": 1396, "media_type": "tv", "name": "Breaking Bad", "original_name": "Breaking Bad",
+		 "first_air_date": "2008-01-20", "character": "Walter White", "episode_count": 62},
+		{"id": 2048, "media_type": "movie", "title": "Drive", "release_date": "2011-09-15", "character": "Shannon"}
+	]}`
+
+	var response models.ActorCreditsResponse
+	if err := json.Unmarshal([]byte(data), &response); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	credit := response.Cast[0]
+	if !credit.IsShow() || response.Cast[1].IsShow() {
+		t.Fatal("Expected the first credit to be a show and the second a movie")
+	}
+
+	show := credit.ToShow()
+	if show.ID != 1396 || show.Name != "Breaking Bad" || show.FirstAirDate != "2008-01-20" {
+		t.Errorf("Unexpected show: %+v", show)
+	}
+	if credit.EpisodeCount != 62 || credit.Character != "Walter White" {
+		t.Errorf("Expected 62 episodes as Walter White, got %d as '%s'", credit.EpisodeCount, credit.Character)
+	}
+}
+
+func TestPersonDetailsAge(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		person   models.PersonDetails
+		expected int
+		ok       bool
+	}{
+		{"Birthday passed", models.PersonDetails{Birthday: "1970-01-15"}, 56, true},
+		{"Birthday ahead", models.PersonDetails{Birthday: "1970-07-30"}, 55, true},
+		{"Died", models.PersonDetails{Birthday: "1930-05-31", Deathday: "2020-05-30"}, 89, true},
+		{"Unknown birthday", models.PersonDetails{}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			age, ok := tt.person.Age(now)
+			if ok != tt.ok || age != tt.expected {
+				t.Errorf("Expected %d (%v), got %d (%v)", tt.expected, tt.ok, age, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+func TestCertificationAge(t *testing.T) {
+	tests := []struct {
+		region        string
+		certification string
+		expectedAge   int
+		expectedOK    bool
+	}{
+		{"DE", "12", 12, true},
+		{"DE", "FSK 16", 16, true},
+		{"US", "PG-13", 13, true},
+		{"US", "tv-ma", 17, true},
+		{"GB", "12A", 12, true},
+		{"NL", "16+", 16, true},
+		{"US", "NR", 0, false},
+		{"DE", "", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.region+" "+tt.certification, func(t *testing.T) {
+			age, ok := models.CertificationAge(tt.region, tt.certification)
+			if age != tt.expectedAge || ok != tt.expectedOK {
+				t.Errorf("Expected (%d, %v), got (%d, %v)", tt.expectedAge, tt.expectedOK, age, ok)
+			}
+		})
+	}
+}
+
+func TestReleaseDatesResponseCertification(t *testing.T) {
+	response := models.ReleaseDatesResponse{
+		Results: []models.CountryReleaseDates{
+			{
+				CountryCode: "DE",
+				ReleaseDates: []models.ReleaseDate{
+					{Certification: "", ReleaseDate: "2024-01-01T00:00:00.000Z", Type: models.ReleaseTypePremiere},
+					{Certification: "16", ReleaseDate: "2024-02-01T00:00:00.000Z", Type: models.ReleaseTypeDigital},
+					{Certification: "12", ReleaseDate: "2024-03-01T00:00:00.000Z", Type: models.ReleaseTypeTheatrical},
+				},
+			},
+			{
+				CountryCode:  "US",
+				ReleaseDates: []models.ReleaseDate{{Certification: "R", ReleaseDate: "2024-01-01", Type: models.ReleaseTypeDigital}},
+			},
+		},
+	}
+
+	if cert := response.Certification("DE"); cert != "12" {
+		t.Errorf("Expected the theatrical certification '12', got '%s'", cert)
+	}
+	if cert := response.Certification("US"); cert != "R" {
+		t.Errorf("Expected 'R', got '%s'", cert)
+	}
+	if cert := response.Certification("FR"); cert != "" {
+		t.Errorf("Expected no certification, got '%s'", cert)
+	}
+}
+
+func TestContentRatingsResponseCertification(t *testing.T) {
+	response := models.ContentRatingsResponse{
+		Results: []models.ContentRating{
+			{CountryCode: "DE", Rating: "16"},
+			{CountryCode: "US", Rating: "TV-MA"},
+		},
+	}
+
+	if rating := response.Certification("us"); rating != "TV-MA" {
+		t.Errorf("Expected 'TV-MA', got '%s'", rating)
+	}
+	if rating := response.Certification("GB"); rating != "" {
+		t.Errorf("Expected no rating, got '%s'", rating)
+	}
+}
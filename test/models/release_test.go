@@ -0,0 +1,73 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+)
+
+func TestReleaseDatesForRegion(t *testing.T) {
+	response := models.ReleaseDatesResponse{
+		ID: 1,
+		Results: []models.CountryReleaseDates{
+			{
+				CountryCode: "US",
+				ReleaseDates: []models.ReleaseDate{
+					{ReleaseDate: "2025-10-01T00:00:00.000Z", Type: models.ReleaseTypeTheatrical},
+				},
+			},
+			{
+				CountryCode: "DE",
+				ReleaseDates: []models.ReleaseDate{
+					{ReleaseDate: "2026-01-15T00:00:00.000Z", Type: models.ReleaseTypeDigital},
+					{ReleaseDate: "2025-10-22T00:00:00.000Z", Type: models.ReleaseTypeTheatrical},
+				},
+			},
+		},
+	}
+
+	dates := response.ForRegion("DE")
+	if len(dates) != 2 {
+		t.Fatalf("Expected 2 release dates for DE, got %d", len(dates))
+	}
+	if dates[0].GetDate() != "2025-10-22" {
+		t.Errorf("Expected earliest date first, got '%s'", dates[0].GetDate())
+	}
+	if dates[0].GetTypeName() != "Theatrical" {
+		t.Errorf("Expected type 'Theatrical', got '%s'", dates[0].GetTypeName())
+	}
+	if dates[1].GetTypeName() != "Digital" {
+		t.Errorf("Expected type 'Digital', got '%s'", dates[1].GetTypeName())
+	}
+
+	if dates := response.ForRegion("de"); len(dates) != len(response.ForRegion("DE")) {
+		t.Errorf("Expected a lowercase region to match, got %d dates", len(dates))
+	}
+	if dates := response.ForRegion("FR"); len(dates) != 0 {
+		t.Errorf("Expected no release dates for FR, got %d", len(dates))
+	}
+}
+
+func TestReleaseDateTypeNames(t *testing.T) {
+	tests := []struct {
+		releaseType int
+		expected    string
+	}{
+		{models.ReleaseTypePremiere, "Premiere"},
+		{models.ReleaseTypeTheatricalLimited, "Theatrical (limited)"},
+		{models.ReleaseTypeTheatrical, "Theatrical"},
+		{models.ReleaseTypeDigital, "Digital"},
+		{models.ReleaseTypePhysical, "Physical"},
+		{models.ReleaseTypeTV, "TV"},
+		{0, "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			d := models.ReleaseDate{Type: tt.releaseType}
+			if d.GetTypeName() != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, d.GetTypeName())
+			}
+		})
+	}
+}
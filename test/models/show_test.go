@@ -2,6 +2,7 @@ package models
 
 import (
 	"testing"
+	"time"
 
 	"github.com/sebastianneubert/tmdb/internal/models"
 )
@@ -370,3 +371,79 @@ func TestShowDiscoverResponse(t *testing.T) {
 		t.Errorf("Expected first show 'Breaking Bad', got '%s'", response.Results[0].Name)
 	}
 }
+
+func TestEpisodeGetCode(t *testing.T) {
+	episode := models.Episode{SeasonNumber: 2, EpisodeNumber: 9}
+	if episode.GetCode() != "S02E09" {
+		t.Errorf("Expected 'S02E09', got '%s'", episode.GetCode())
+	}
+}
+
+func TestShowDetailsHasSeasonPremiereWithin(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		details  models.ShowDetails
+		expected bool
+	}{
+		{
+			name: "Premiere in three days",
+			details: models.ShowDetails{
+				NextEpisodeToAir: &models.Episode{AirDate: "2026-10-21", SeasonNumber: 3, EpisodeNumber: 1},
+			},
+			expected: true,
+		},
+		{
+			name: "Premiere aired today",
+			details: models.ShowDetails{
+				LastEpisodeToAir: &models.Episode{AirDate: "2026-10-18", SeasonNumber: 3, EpisodeNumber: 1},
+				NextEpisodeToAir: &models.Episode{AirDate: "2026-10-25", SeasonNumber: 3, EpisodeNumber: 2},
+			},
+			expected: true,
+		},
+		{
+			name: "Premiere aired last week",
+			details: models.ShowDetails{
+				LastEpisodeToAir: &models.Episode{AirDate: "2026-10-13", SeasonNumber: 3, EpisodeNumber: 1},
+				NextEpisodeToAir: &models.Episode{AirDate: "2026-10-20", SeasonNumber: 3, EpisodeNumber: 2},
+			},
+			expected: false,
+		},
+		{
+			name: "Premiere in seven days",
+			details: models.ShowDetails{
+				NextEpisodeToAir: &models.Episode{AirDate: "2026-10-25", SeasonNumber: 3, EpisodeNumber: 1},
+			},
+			expected: true,
+		},
+		{
+			name: "Regular episode",
+			details: models.ShowDetails{
+				NextEpisodeToAir: &models.Episode{AirDate: "2026-10-20", SeasonNumber: 3, EpisodeNumber: 4},
+			},
+			expected: false,
+		},
+		{
+			name: "Premiere next month",
+			details: models.ShowDetails{
+				NextEpisodeToAir: &models.Episode{AirDate: "2026-11-20", SeasonNumber: 4, EpisodeNumber: 1},
+			},
+			expected: false,
+		},
+		{
+			name:     "No episode data",
+			details:  models.ShowDetails{},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.details.HasSeasonPremiereWithin(now, 7)
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -4,6 +4,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/sebastianneubert/tmdb/internal/api"
+	"github.com/sebastianneubert/tmdb/internal/config"
+	"github.com/sebastianneubert/tmdb/internal/expr"
+	"github.com/sebastianneubert/tmdb/internal/filters"
 	"github.com/sebastianneubert/tmdb/internal/models"
 	"github.com/sebastianneubert/tmdb/internal/processor"
 )
@@ -311,3 +315,374 @@ func TestMovieProcessorEmptyResults(t *testing.T) {
 		t.Errorf("processFunc should not be called for empty results, but was called %d times", processCallCount)
 	}
 }
+
+func TestMovieProcessorDateRangeFilter(t *testing.T) {
+	dateRange, err := filters.ParseDateRange(0, "", "", "1990s")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	mp := processor.NewMovieProcessor(nil, processor.FilterConfig{
+		MinRating: 0,
+		MinVotes:  0,
+		Region:    "US",
+		DateRange: dateRange,
+	})
+
+	processedIDs := []int{}
+	processFunc := func(movie *models.Movie, providers []string, genres []string) error {
+		processedIDs = append(processedIDs, movie.ID)
+		return nil
+	}
+
+	fetchFunc := func(page int) (*models.DiscoverResponse, error) {
+		return &models.DiscoverResponse{
+			Results: []models.Movie{
+				{ID: 1, Title: "Pulp Fiction", ReleaseDate: "1994-09-10"},
+				{ID: 2, Title: "Inception", ReleaseDate: "2010-07-15"},
+				{ID: 3, Title: "Unknown Date"},
+				{ID: 4, Title: "The Matrix", ReleaseDate: "1999-03-31"},
+			},
+			TotalPages: 1,
+		}, nil
+	}
+
+	if err := mp.Process(fetchFunc, processFunc); err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+
+	if len(processedIDs) != 2 || processedIDs[0] != 1 || processedIDs[1] != 4 {
+		t.Errorf("Expected movies 1 and 4 to pass the 1990s filter, got %v", processedIDs)
+	}
+}
+
+func TestMovieProcessorProcessShowsDateRangeFilter(t *testing.T) {
+	dateRange, err := filters.ParseDateRange(0, "2018", "", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	mp := processor.NewMovieProcessor(nil, processor.FilterConfig{
+		MinRating: 8.0,
+		MinVotes:  100,
+		Region:    "US",
+		DateRange: dateRange,
+	})
+
+	processedIDs := []int{}
+	processFunc := func(show *models.Show, providers []string) error {
+		processedIDs = append(processedIDs, show.ID)
+		return nil
+	}
+
+	fetchFunc := func(page int) (*models.ShowDiscoverResponse, error) {
+		return &models.ShowDiscoverResponse{
+			Results: []models.Show{
+				{ID: 1, Name: "Breaking Bad", FirstAirDate: "2008-01-20", VoteAverage: 8.9, VoteCount: 5000},
+				{ID: 2, Name: "Succession", FirstAirDate: "2018-06-03", VoteAverage: 8.4, VoteCount: 2000},
+				{ID: 3, Name: "Low Rated", FirstAirDate: "2019-01-01", VoteAverage: 6.0, VoteCount: 2000},
+			},
+			TotalPages: 1,
+		}, nil
+	}
+
+	if err := mp.ProcessShows(fetchFunc, processFunc); err != nil {
+		t.Fatalf("ProcessShows failed: %v", err)
+	}
+
+	if len(processedIDs) != 1 || processedIDs[0] != 2 {
+		t.Errorf("Expected only show 2 to pass, got %v", processedIDs)
+	}
+}
+
+func TestMovieProcessorWhereExpression(t *testing.T) {
+	where, err := expr.Parse(`rating >= 7 && year in 1990..1999 && "Comedy" in genres`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	mp := processor.NewMovieProcessor(nil, processor.FilterConfig{
+		Region:    "US",
+		GenreList: []models.Genre{{ID: 35, Name: "Comedy"}, {ID: 18, Name: "Drama"}},
+		Where:     where,
+	})
+
+	processedIDs := []int{}
+	processFunc := func(movie *models.Movie, providers []string, genres []string) error {
+		processedIDs = append(processedIDs, movie.ID)
+		return nil
+	}
+
+	fetchFunc := func(page int) (*models.DiscoverResponse, error) {
+		return &models.DiscoverResponse{
+			Results: []models.Movie{
+				{ID: 1, Title: "Groundhog Day", ReleaseDate: "1993-02-11", VoteAverage: 8.0, GenreIDs: []int{35}},
+				{ID: 2, Title: "Schindler's List", ReleaseDate: "1993-12-15", VoteAverage: 8.6, GenreIDs: []int{18}},
+				{ID: 3, Title: "Superbad", ReleaseDate: "2007-08-17", VoteAverage: 7.3, GenreIDs: []int{35}},
+				{ID: 4, Title: "Weak Comedy", ReleaseDate: "1995-01-01", VoteAverage: 5.0, GenreIDs: []int{35}},
+			},
+			TotalPages: 1,
+		}, nil
+	}
+
+	if err := mp.Process(fetchFunc, processFunc); err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+
+	if len(processedIDs) != 1 || processedIDs[0] != 1 {
+		t.Errorf("Expected only movie 1 to match the expression, got %v", processedIDs)
+	}
+}
+
+func TestMovieProcessorWhereExpressionUsesEnglishGenres(t *testing.T) {
+	where, err := expr.Parse(`"Comedy" in genres`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	mp := processor.NewMovieProcessor(nil, processor.FilterConfig{
+		Region:           "DE",
+		GenreList:        []models.Genre{{ID: 35, Name: "Komödie"}, {ID: 18, Name: "Drama"}},
+		EnglishGenreList: []models.Genre{{ID: 35, Name: "Comedy"}, {ID: 18, Name: "Drama"}},
+		Where:            where,
+	})
+
+	if !mp.MatchesMovieExpression(&models.Movie{ID: 1, GenreIDs: []int{35}}, nil) {
+		t.Error("Expected a comedy to match \"Comedy\" in genres with a German genre list")
+	}
+	if mp.MatchesMovieExpression(&models.Movie{ID: 2, GenreIDs: []int{18}}, nil) {
+		t.Error("Expected a drama not to match \"Comedy\" in genres")
+	}
+	if !mp.MatchesShowExpression(&models.Show{ID: 3, GenreIDs: []int{35}}, nil) {
+		t.Error("Expected a comedy show to match \"Comedy\" in genres")
+	}
+}
+
+func TestMovieProcessorCollectAll(t *testing.T) {
+	fetchFunc := func(page int) (*models.DiscoverResponse, error) {
+		movies := make([]models.Movie, 20)
+		for i := range movies {
+			movies[i] = models.Movie{ID: (page-1)*20 + i + 1, VoteAverage: 7.0, VoteCount: 100}
+		}
+		return &models.DiscoverResponse{Results: movies, TotalPages: config.MaxPagesToSearch}, nil
+	}
+
+	for _, collectAll := range []bool{false, true} {
+		mp := processor.NewMovieProcessor(nil, processor.FilterConfig{
+			Region:     "US",
+			CollectAll: collectAll,
+		})
+
+		processed := 0
+		err := mp.Process(fetchFunc, func(movie *models.Movie, providers []string, genres []string) error {
+			processed++
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Process failed: %v", err)
+		}
+
+		expected := config.MaxResultsToDisplay
+		if collectAll {
+			expected = config.MaxPagesToSearch * 20
+		}
+		if processed != expected {
+			t.Errorf("CollectAll=%v: expected %d movies, got %d", collectAll, expected, processed)
+		}
+	}
+}
+
+func TestMovieProcessorLookupHook(t *testing.T) {
+	client, err := api.NewClient("test-key", 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	mp := processor.NewMovieProcessor(client, processor.FilterConfig{Region: "US", MinRuntime: 90})
+
+	// The hook refuses every lookup, so no request is sent
+	errBudget := errors.New("budget exceeded")
+	calls := 0
+	mp.SetLookupHook(func() error {
+		calls++
+		return errBudget
+	})
+
+	if mp.MatchesMovie(&models.Movie{ID: 1, VoteAverage: 8.0}) {
+		t.Error("Expected no match when the runtime lookup is refused")
+	}
+	if !errors.Is(mp.LookupErr(), errBudget) {
+		t.Errorf("Expected the hook error, got %v", mp.LookupErr())
+	}
+
+	// Titles that need no lookup still match, and the hook is not called again
+	if !mp.MatchesMovie(&models.Movie{ID: 2, VoteAverage: 8.0, Runtime: 120}) {
+		t.Error("Expected a movie with a known runtime to match")
+	}
+	mp.MatchesMovie(&models.Movie{ID: 3, VoteAverage: 8.0})
+	if calls != 1 {
+		t.Errorf("Expected the hook to be called once, got %d", calls)
+	}
+}
+
+func TestMovieProcessorProcessShowsOriginFilter(t *testing.T) {
+	mp := processor.NewMovieProcessor(nil, processor.FilterConfig{
+		Region:            "US",
+		OriginalLanguages: []string{"sv", "da"},
+		OriginCountries:   []string{"SE", "DK"},
+	})
+
+	processedIDs := []int{}
+	processFunc := func(show *models.Show, providers []string) error {
+		processedIDs = append(processedIDs, show.ID)
+		return nil
+	}
+
+	fetchFunc := func(page int) (*models.ShowDiscoverResponse, error) {
+		return &models.ShowDiscoverResponse{
+			Results: []models.Show{
+				{ID: 1, Name: "The Bridge", OriginalLanguage: "sv", OriginCountry: []string{"SE", "DK"}},
+				{ID: 2, Name: "The Killing", OriginalLanguage: "en", OriginCountry: []string{"US"}},
+				{ID: 3, Name: "Borgen", OriginalLanguage: "da", OriginCountry: []string{"DK"}},
+				{ID: 4, Name: "Swedish, made elsewhere", OriginalLanguage: "sv", OriginCountry: []string{"FI"}},
+			},
+			TotalPages: 1,
+		}, nil
+	}
+
+	if err := mp.ProcessShows(fetchFunc, processFunc); err != nil {
+		t.Fatalf("ProcessShows failed: %v", err)
+	}
+
+	if len(processedIDs) != 2 || processedIDs[0] != 1 || processedIDs[1] != 3 {
+		t.Errorf("Expected shows 1 and 3 to pass the origin filters, got %v", processedIDs)
+	}
+}
+
+func TestMovieProcessorCertificationFilter(t *testing.T) {
+	mp := processor.NewMovieProcessor(nil, processor.FilterConfig{
+		Region:              "DE",
+		MaxCertification:    "12",
+		MaxCertificationAge: 12,
+	})
+
+	tests := []struct {
+		movie    models.Movie
+		expected bool
+	}{
+		{models.Movie{ID: 1, Title: "Paddington", Certification: "0"}, true},
+		{models.Movie{ID: 2, Title: "Jurassic Park", Certification: "12"}, true},
+		{models.Movie{ID: 3, Title: "Alien", Certification: "16"}, false},
+		{models.Movie{ID: 4, Title: "Unrated"}, false},
+	}
+
+	for _, tt := range tests {
+		if result := mp.MatchesMovie(&tt.movie); result != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.movie.Title, tt.expected, result)
+		}
+	}
+
+	params := processor.FilterConfig{Region: "DE", MaxCertification: "12"}.DiscoverParams("popularity.desc")
+	if params.CertificationCountry != "DE" || params.MaxCertification != "12" {
+		t.Errorf("Expected the certification to be pushed down, got %+v", params)
+	}
+}
+
+func TestMovieProcessorKeywordFilter(t *testing.T) {
+	mp := processor.NewMovieProcessor(nil, processor.FilterConfig{
+		Region: "US",
+		Keywords: filters.KeywordFilter{
+			Include: []models.Keyword{{ID: 10051, Name: "heist"}},
+			Exclude: []models.Keyword{{ID: 818, Name: "based on novel or book"}},
+		},
+	})
+
+	heist := models.Movie{ID: 1, Title: "Heat", KeywordIDs: []int{10051}}
+	novel := models.Movie{ID: 2, Title: "The Italian Job", KeywordIDs: []int{10051, 818}}
+	other := models.Movie{ID: 3, Title: "Up", KeywordIDs: []int{}}
+
+	if !mp.MatchesMovie(&heist) {
+		t.Error("Expected the heist movie to match")
+	}
+	if mp.MatchesMovie(&novel) {
+		t.Error("Expected the excluded keyword to reject the movie")
+	}
+	if mp.MatchesMovie(&other) {
+		t.Error("Expected a movie without the keyword to be rejected")
+	}
+}
+
+func TestMovieProcessorCompanyAndNetworkFilter(t *testing.T) {
+	a24 := models.Company{ID: 41077, Name: "A24"}
+	hbo := models.Network{ID: 49, Name: "HBO"}
+	mp := processor.NewMovieProcessor(nil, processor.FilterConfig{
+		Region:    "US",
+		Companies: []models.Company{a24},
+		Networks:  []models.Network{hbo},
+	})
+
+	byA24 := models.Movie{ID: 1, Title: "Moonlight", ProductionCompanies: []models.Company{{ID: 2}, a24}}
+	other := models.Movie{ID: 2, Title: "Up", ProductionCompanies: []models.Company{{ID: 3, Name: "Pixar"}}}
+	if !mp.MatchesMovie(&byA24) {
+		t.Error("Expected the A24 movie to match")
+	}
+	if mp.MatchesMovie(&other) {
+		t.Error("Expected a movie by another studio to be rejected")
+	}
+
+	onHBO := models.Show{ID: 3, Name: "Euphoria", Networks: []models.Network{hbo}, ProductionCompanies: []models.Company{a24}}
+	wrongNetwork := models.Show{ID: 4, Name: "Beef", Networks: []models.Network{{ID: 213, Name: "Netflix"}}, ProductionCompanies: []models.Company{a24}}
+	if !mp.MatchesShow(&onHBO) {
+		t.Error("Expected the HBO show to match")
+	}
+	if mp.MatchesShow(&wrongNetwork) {
+		t.Error("Expected a show on another network to be rejected")
+	}
+}
+
+func TestMovieProcessorSkipsSeenTitles(t *testing.T) {
+	seen := filters.NewSeenFilter()
+	seen.Add(models.MediaTypeMovie, 2)
+	seen.Add(models.MediaTypeTV, 1)
+
+	mp := processor.NewMovieProcessor(nil, processor.FilterConfig{Region: "US", Seen: seen})
+
+	processedIDs := []int{}
+	err := mp.Process(func(page int) (*models.DiscoverResponse, error) {
+		return &models.DiscoverResponse{
+			Results:    []models.Movie{{ID: 1, Title: "Heat"}, {ID: 2, Title: "Inception"}},
+			TotalPages: 1,
+		}, nil
+	}, func(movie *models.Movie, providers []string, genres []string) error {
+		processedIDs = append(processedIDs, movie.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	if len(processedIDs) != 1 || processedIDs[0] != 1 {
+		t.Errorf("Expected only the unseen movie 1, got %v", processedIDs)
+	}
+
+	// Show IDs are separate from movie IDs: movie 1 is seen as a show only
+	processedIDs = []int{}
+	err = mp.ProcessShows(func(page int) (*models.ShowDiscoverResponse, error) {
+		return &models.ShowDiscoverResponse{
+			Results:    []models.Show{{ID: 1, Name: "The Office"}, {ID: 2, Name: "Lost"}},
+			TotalPages: 1,
+		}, nil
+	}, func(show *models.Show, providers []string) error {
+		processedIDs = append(processedIDs, show.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ProcessShows failed: %v", err)
+	}
+	if len(processedIDs) != 1 || processedIDs[0] != 2 {
+		t.Errorf("Expected only the unseen show 2, got %v", processedIDs)
+	}
+
+	if descriptions := (processor.FilterConfig{Seen: seen}).Describe(); len(descriptions) != 1 || descriptions[0] != "Hiding 2 seen titles" {
+		t.Errorf("Expected the seen filter to be described, got %v", descriptions)
+	}
+}
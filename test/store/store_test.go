@@ -0,0 +1,109 @@
+package store
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sebastianneubert/tmdb/internal/display"
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/store"
+)
+
+func TestListRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "watchlist.json")
+
+	list, err := store.LoadList(path)
+	if err != nil {
+		t.Fatalf("Unexpected error loading a missing list: %v", err)
+	}
+	if len(list.Items) != 0 {
+		t.Fatalf("Expected a missing list to be empty, got %d items", len(list.Items))
+	}
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	movie := models.Movie{ID: 27205, Title: "Inception", ReleaseDate: "2010-07-15"}
+	show := models.Movie{ID: 1396, Name: "Breaking Bad", FirstAirDate: "2008-01-20", MediaType: models.MediaTypeTV}
+
+	if !list.Add(store.NewItem(movie, now)) || !list.Add(store.NewItem(show, now)) {
+		t.Fatal("Expected new titles to be added")
+	}
+	if list.Add(store.NewItem(movie, now)) {
+		t.Error("Expected a title already on the list not to be added again")
+	}
+	if err := list.Save(); err != nil {
+		t.Fatalf("Unexpected error saving: %v", err)
+	}
+
+	loaded, err := store.LoadList(path)
+	if err != nil {
+		t.Fatalf("Unexpected error loading: %v", err)
+	}
+	if len(loaded.Items) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(loaded.Items))
+	}
+	if item := loaded.Items[0]; item.MediaType != models.MediaTypeMovie || item.Title != "Inception" || item.Year != "2010" || !item.AddedAt.Equal(now) {
+		t.Errorf("Unexpected movie item %+v", item)
+	}
+	if !loaded.Contains(models.MediaTypeTV, 1396) || loaded.Contains(models.MediaTypeMovie, 1396) {
+		t.Error("Expected items to be told apart by media type")
+	}
+
+	if !loaded.Remove(models.MediaTypeMovie, 27205) || loaded.Remove(models.MediaTypeMovie, 27205) {
+		t.Error("Expected Remove to report whether the title was on the list")
+	}
+	if len(loaded.Items) != 1 {
+		t.Errorf("Expected 1 item after removing, got %d", len(loaded.Items))
+	}
+}
+
+func TestHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shell_history")
+
+	if lines, err := store.LoadHistory(path); err != nil || len(lines) != 0 {
+		t.Fatalf("Expected no history for a missing file, got %q, %v", lines, err)
+	}
+
+	for i := 0; i < store.MaxHistory+2; i++ {
+		if err := store.AppendHistory(path, fmt.Sprintf("top --page %d", i)); err != nil {
+			t.Fatalf("Unexpected error appending: %v", err)
+		}
+	}
+
+	lines, err := store.LoadHistory(path)
+	if err != nil {
+		t.Fatalf("Unexpected error loading: %v", err)
+	}
+	if len(lines) != store.MaxHistory {
+		t.Fatalf("Expected %d lines, got %d", store.MaxHistory, len(lines))
+	}
+	if lines[0] != "top --page 2" || lines[len(lines)-1] != fmt.Sprintf("top --page %d", store.MaxHistory+1) {
+		t.Errorf("Expected the last lines to be kept, got %q ... %q", lines[0], lines[len(lines)-1])
+	}
+}
+
+func TestResultsRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "last_results.json")
+
+	if results, err := store.LoadResults(path); err != nil || len(results) != 0 {
+		t.Fatalf("Expected no results for a missing file, got %v, %v", results, err)
+	}
+
+	saved := []display.Result{
+		{Number: 1, MediaType: models.MediaTypeMovie, ID: 27205, Title: "Inception", Year: "2010"},
+		{Number: 2, MediaType: models.MediaTypeTV, ID: 1438, Title: "The Wire", Year: "2002"},
+	}
+	if err := store.SaveResults(path, saved); err != nil {
+		t.Fatalf("Unexpected error saving: %v", err)
+	}
+
+	results, err := store.LoadResults(path)
+	if err != nil {
+		t.Fatalf("Unexpected error loading: %v", err)
+	}
+	if !reflect.DeepEqual(results, saved) {
+		t.Errorf("Expected %+v, got %+v", saved, results)
+	}
+}
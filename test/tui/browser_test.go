@@ -0,0 +1,161 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/models"
+	"github.com/sebastianneubert/tmdb/internal/tui"
+)
+
+// pagedSource serves pages of 10 titles rated 5.0 to 9.5 and records the pages loaded
+type pagedSource struct {
+	pages      []int
+	totalPages int
+}
+
+func (s *pagedSource) load(query string, page int) ([]models.Movie, int, error) {
+	s.pages = append(s.pages, page)
+	items := make([]models.Movie, 10)
+	for i := range items {
+		id := page*100 + i
+		items[i] = models.Movie{ID: id, Title: fmt.Sprintf("%s Movie %d", query, id), VoteAverage: 5 + float64(i)*0.5, GenreIDs: []int{18 + i%2}}
+	}
+	return items, s.totalPages, nil
+}
+
+func newBrowser(source *pagedSource, providers []string) *tui.Browser {
+	genres := []models.Genre{{ID: 18, Name: "Drama"}, {ID: 19, Name: "Comedy"}}
+	browser := tui.NewBrowser([]tui.Source{
+		{Name: "Top", Load: source.load},
+		{Name: "Search", Prompt: "Search", Load: source.load},
+	}, providers, genres)
+	browser.Start()
+	return browser
+}
+
+func press(browser *tui.Browser, keys ...tui.Key) {
+	for _, key := range keys {
+		browser.Update(key)
+	}
+}
+
+func TestBrowserLazyPagination(t *testing.T) {
+	source := &pagedSource{totalPages: 3}
+	browser := newBrowser(source, nil)
+
+	if len(source.pages) != 1 {
+		t.Fatalf("Expected only the first page to be loaded, got %v", source.pages)
+	}
+
+	for i := 0; i < 4; i++ {
+		press(browser, tui.Key{Name: tui.KeyDown})
+	}
+	if len(source.pages) != 1 {
+		t.Errorf("Expected no new page far from the end, got %v", source.pages)
+	}
+
+	press(browser, tui.Key{Rune: 'j'})
+	if len(source.pages) != 2 || source.pages[1] != 2 {
+		t.Errorf("Expected page 2 to be loaded near the end, got %v", source.pages)
+	}
+
+	for i := 0; i < 30; i++ {
+		press(browser, tui.Key{Name: tui.KeyDown})
+	}
+	if len(source.pages) != 3 {
+		t.Errorf("Expected loading to stop at the last page, got %v", source.pages)
+	}
+	if item, _ := browser.Selected(); item.ID != 309 {
+		t.Errorf("Expected the cursor to stop at the last title, got %d", item.ID)
+	}
+}
+
+func TestBrowserRatingAndGenreFilters(t *testing.T) {
+	source := &pagedSource{totalPages: 1}
+	browser := newBrowser(source, nil)
+
+	// Focus the rating slider and raise the minimum to 9.0
+	press(browser, tui.Key{Name: tui.KeyTab})
+	for i := 0; i < 18; i++ {
+		press(browser, tui.Key{Name: tui.KeyRight})
+	}
+	if item, ok := browser.Selected(); !ok || item.VoteAverage != 9 {
+		t.Fatalf("Expected the first title rated 9.0 or higher, got %+v", item)
+	}
+
+	// Focus the genre chips and select Comedy only
+	press(browser, tui.Key{Name: tui.KeyTab}, tui.Key{Name: tui.KeyTab}, tui.Key{Name: tui.KeyRight}, tui.Key{Rune: ' '})
+	if item, ok := browser.Selected(); !ok || item.VoteAverage != 9.5 {
+		t.Errorf("Expected the comedy rated 9.5, got %+v", item)
+	}
+
+	view := browser.View(100, 30)
+	if !strings.Contains(view, "[x] Comedy") || !strings.Contains(view, "9.0") {
+		t.Errorf("Expected the view to show the filters, got:\n%s", view)
+	}
+}
+
+func TestBrowserProviderToggles(t *testing.T) {
+	source := &pagedSource{totalPages: 1}
+	browser := tui.NewBrowser([]tui.Source{{Name: "Top", Load: source.load}}, []string{"Netflix", "Disney Plus"}, nil)
+	browser.Providers = func(item models.Movie) (models.RegionProviders, error) {
+		provider := "Netflix"
+		if item.ID%2 == 1 {
+			provider = "Disney Plus"
+		}
+		return models.RegionProviders{Flatrate: []models.Provider{{ProviderName: provider}}}, nil
+	}
+	browser.Start()
+
+	if item, _ := browser.Selected(); item.ID != 100 {
+		t.Fatalf("Expected the first title, got %d", item.ID)
+	}
+
+	// Turn Netflix off
+	press(browser, tui.Key{Name: tui.KeyTab}, tui.Key{Name: tui.KeyTab}, tui.Key{Rune: ' '})
+	if item, _ := browser.Selected(); item.ID != 101 {
+		t.Errorf("Expected the first Disney Plus title, got %d", item.ID)
+	}
+
+	// Turning Disney Plus off too ignores availability
+	press(browser, tui.Key{Name: tui.KeyRight}, tui.Key{Rune: ' '})
+	if item, _ := browser.Selected(); item.ID != 100 {
+		t.Errorf("Expected all titles with all providers toggled off, got %d", item.ID)
+	}
+}
+
+func TestBrowserSearchAndBookmark(t *testing.T) {
+	source := &pagedSource{totalPages: 1}
+	browser := newBrowser(source, nil)
+
+	bookmarked := map[int]bool{}
+	browser.Bookmark = func(item models.Movie) (bool, error) {
+		if bookmarked[item.ID] {
+			return false, nil
+		}
+		bookmarked[item.ID] = true
+		return true, nil
+	}
+
+	press(browser, tui.Key{Rune: '/'}, tui.Key{Rune: 'd'}, tui.Key{Rune: 'u'}, tui.Key{Rune: 'x'}, tui.Key{Name: tui.KeyBackspace}, tui.Key{Rune: 'n'}, tui.Key{Rune: 'e'}, tui.Key{Name: tui.KeyEnter})
+	item, ok := browser.Selected()
+	if !ok || !strings.HasPrefix(item.Title, "dune ") {
+		t.Fatalf("Expected search results for the typed query, got %+v", item)
+	}
+
+	press(browser, tui.Key{Rune: 'b'})
+	if !bookmarked[item.ID] {
+		t.Error("Expected the selected title to be bookmarked")
+	}
+	press(browser, tui.Key{Rune: 'b'})
+	if !strings.Contains(browser.View(100, 30), "already on your watchlist") {
+		t.Error("Expected a second bookmark to report the title is already listed")
+	}
+
+	press(browser, tui.Key{Rune: 'q'})
+	if !browser.Done() {
+		t.Error("Expected q to quit")
+	}
+}
@@ -0,0 +1,144 @@
+package tui
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/tui"
+)
+
+func TestReadKey(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("\x1b[A\x1b[Bj3\r\x7fä"))
+
+	expected := []tui.Key{
+		{Name: tui.KeyUp},
+		{Name: tui.KeyDown},
+		{Rune: 'j'},
+		{Rune: '3'},
+		{Name: tui.KeyEnter},
+		{Name: tui.KeyBackspace},
+		{Rune: 'ä'},
+	}
+	for i, want := range expected {
+		key, err := tui.ReadKey(r)
+		if err != nil {
+			t.Fatalf("Unexpected error at key %d: %v", i, err)
+		}
+		if key != want {
+			t.Errorf("Expected key %d to be %+v, got %+v", i, want, key)
+		}
+	}
+
+	if key, _ := tui.ReadKey(bufio.NewReader(strings.NewReader("\x1b"))); key.Name != tui.KeyEscape {
+		t.Errorf("Expected a lone ESC to be escape, got %+v", key)
+	}
+}
+
+func newPicker(n int) *tui.Picker {
+	items := make([]tui.Item, n)
+	for i := range items {
+		items[i] = tui.Item{Title: "Tom " + string(rune('A'+i)), Detail: "Acting"}
+	}
+	return &tui.Picker{Prompt: "Which one?", Items: items}
+}
+
+func TestPickerArrowSelection(t *testing.T) {
+	picker := newPicker(3)
+
+	for _, key := range []tui.Key{{Name: tui.KeyDown}, {Name: tui.KeyDown}, {Name: tui.KeyDown}, {Rune: 'k'}} {
+		if done, _ := picker.Update(key); done {
+			t.Fatal("Expected the picker to keep running")
+		}
+	}
+	if picker.Cursor != 1 {
+		t.Errorf("Expected the cursor at 1, got %d", picker.Cursor)
+	}
+
+	done, selected := picker.Update(tui.Key{Name: tui.KeyEnter})
+	if !done || !selected || picker.Cursor != 1 {
+		t.Errorf("Expected the second item to be selected, got done=%v selected=%v cursor=%d", done, selected, picker.Cursor)
+	}
+}
+
+func TestPickerNumberSelection(t *testing.T) {
+	picker := newPicker(12)
+
+	picker.Update(tui.Key{Rune: '1'})
+	picker.Update(tui.Key{Rune: '2'})
+	if picker.Cursor != 11 {
+		t.Errorf("Expected '12' to move to the 12th item, got %d", picker.Cursor)
+	}
+
+	// 129 does not exist, so 9 starts a new number
+	picker.Update(tui.Key{Rune: '9'})
+	if picker.Cursor != 8 {
+		t.Errorf("Expected '9' to move to the 9th item, got %d", picker.Cursor)
+	}
+
+	picker.Update(tui.Key{Rune: '1'})
+	picker.Update(tui.Key{Rune: '1'})
+	picker.Update(tui.Key{Name: tui.KeyBackspace})
+	if picker.Cursor != 0 {
+		t.Errorf("Expected backspace from '11' to go back to the 1st item, got %d", picker.Cursor)
+	}
+}
+
+func TestPickerCancel(t *testing.T) {
+	for _, key := range []tui.Key{{Name: tui.KeyEscape}, {Name: tui.KeyCtrlC}, {Rune: 'q'}} {
+		done, selected := newPicker(2).Update(key)
+		if !done || selected {
+			t.Errorf("Expected %+v to cancel, got done=%v selected=%v", key, done, selected)
+		}
+	}
+}
+
+func TestPickerView(t *testing.T) {
+	picker := newPicker(2)
+	picker.Cursor = 1
+
+	view := strings.Join(picker.View(0), "\n")
+	if !strings.Contains(view, "Which one?") || !strings.Contains(view, ">  2. Tom B") || !strings.Contains(view, "   1. Tom A") {
+		t.Errorf("Unexpected view:\n%s", view)
+	}
+	if !strings.Contains(view, "Acting") {
+		t.Errorf("Expected the details in the view:\n%s", view)
+	}
+}
+
+func TestPickerViewScrolls(t *testing.T) {
+	// 15 items with details take 34 lines, more than a 24-row terminal
+	picker := newPicker(15)
+	const height = 23
+
+	for i := 0; i < 15; i++ {
+		view := picker.View(height)
+		if len(view) > height {
+			t.Fatalf("Expected at most %d lines with the cursor at %d, got %d", height, picker.Cursor, len(view))
+		}
+		marker := fmt.Sprintf(">  %d. ", picker.Cursor+1)
+		if picker.Cursor >= 9 {
+			marker = fmt.Sprintf("> %d. ", picker.Cursor+1)
+		}
+		if !strings.Contains(strings.Join(view, "\n"), marker) {
+			t.Errorf("Expected the cursor %d to be visible:\n%s", picker.Cursor, strings.Join(view, "\n"))
+		}
+		picker.Update(tui.Key{Name: tui.KeyDown})
+	}
+
+	view := strings.Join(picker.View(height), "\n")
+	if strings.Contains(view, " 1. Tom A") || !strings.Contains(view, "of 15") {
+		t.Errorf("Expected the list to be scrolled to the end:\n%s", view)
+	}
+
+	// Moving back up only scrolls once the cursor leaves the window
+	picker.Update(tui.Key{Name: tui.KeyUp})
+	if after := strings.Join(picker.View(height), "\n"); strings.Split(after, "\n")[1] != strings.Split(view, "\n")[1] {
+		t.Errorf("Expected the window to stay put:\n%s", after)
+	}
+
+	if lines := picker.View(0); len(lines) != 1+15*2+2 {
+		t.Errorf("Expected all items without a height, got %d lines", len(lines))
+	}
+}
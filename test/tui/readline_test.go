@@ -0,0 +1,134 @@
+package tui
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sebastianneubert/tmdb/internal/tui"
+)
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected []string
+	}{
+		{`top --genre Drama`, []string{"top", "--genre", "Drama"}},
+		{`actor "Tom Hanks"  2`, []string{"actor", "Tom Hanks", "2"}},
+		{`search It\'s`, []string{"search", "It's"}},
+		{`find ""`, []string{"find", ""}},
+		{`  `, nil},
+	}
+	for _, tt := range tests {
+		words, err := tui.SplitWords(tt.line)
+		if tt.expected == nil && len(words) == 0 {
+			continue
+		}
+		if err != nil {
+			t.Errorf("SplitWords(%q): unexpected error %v", tt.line, err)
+			continue
+		}
+		if !reflect.DeepEqual(words, tt.expected) {
+			t.Errorf("SplitWords(%q) = %q, expected %q", tt.line, words, tt.expected)
+		}
+	}
+
+	if _, err := tui.SplitWords(`actor "Tom`); !errors.Is(err, tui.ErrUnterminatedQuote) {
+		t.Errorf("Expected an unterminated quote error, got %v", err)
+	}
+}
+
+func typeText(e *tui.LineEditor, text string) {
+	for _, ch := range text {
+		e.Update(tui.Key{Rune: ch})
+	}
+}
+
+func TestLineEditorEditing(t *testing.T) {
+	e := &tui.LineEditor{Prompt: "> "}
+	e.Reset()
+
+	typeText(e, "tpo")
+	e.Update(tui.Key{Name: tui.KeyLeft})
+	e.Update(tui.Key{Name: tui.KeyBackspace})
+	e.Update(tui.Key{Name: tui.KeyRight})
+	typeText(e, "p")
+	e.Update(tui.Key{Name: tui.KeyLeft})
+
+	if line := e.Line(); line != "top" {
+		t.Errorf("Expected line \"top\", got %q", line)
+	}
+	if line, back := e.View(); line != "> top" || back != 1 {
+		t.Errorf("Expected view \"> top\" with the cursor 1 from the end, got %q, %d", line, back)
+	}
+
+	if done, err := e.Update(tui.Key{Name: tui.KeyEnter}); !done || err != nil {
+		t.Errorf("Expected enter to finish the line, got %v, %v", done, err)
+	}
+	if _, err := e.Update(tui.Key{Name: tui.KeyCtrlC}); !errors.Is(err, tui.ErrInterrupt) {
+		t.Errorf("Expected ctrl+c to interrupt, got %v", err)
+	}
+
+	e.Reset()
+	if _, err := e.Update(tui.Key{Rune: 4}); !errors.Is(err, io.EOF) {
+		t.Errorf("Expected ctrl+d on an empty line to be EOF, got %v", err)
+	}
+}
+
+func TestLineEditorHistory(t *testing.T) {
+	e := &tui.LineEditor{History: []string{"top", "shows"}}
+	e.Reset()
+	typeText(e, "act")
+
+	e.Update(tui.Key{Name: tui.KeyUp})
+	if line := e.Line(); line != "shows" {
+		t.Errorf("Expected the last entry, got %q", line)
+	}
+	e.Update(tui.Key{Name: tui.KeyUp})
+	e.Update(tui.Key{Name: tui.KeyUp})
+	if line := e.Line(); line != "top" {
+		t.Errorf("Expected to stop at the first entry, got %q", line)
+	}
+	e.Update(tui.Key{Name: tui.KeyDown})
+	e.Update(tui.Key{Name: tui.KeyDown})
+	if line := e.Line(); line != "act" {
+		t.Errorf("Expected the draft to be restored, got %q", line)
+	}
+}
+
+func TestLineEditorCompletion(t *testing.T) {
+	var gotWords []string
+	e := &tui.LineEditor{Complete: func(words []string, current string) []string {
+		gotWords = words
+		var candidates []string
+		for _, option := range []string{"--genre", "--min-rating", "--min-votes"} {
+			if strings.HasPrefix(option, current) {
+				candidates = append(candidates, option)
+			}
+		}
+		return candidates
+	}}
+	e.Reset()
+
+	typeText(e, `actor "Tom Hanks" --m`)
+	e.Update(tui.Key{Name: tui.KeyTab})
+	if line := e.Line(); line != `actor "Tom Hanks" --min-` {
+		t.Errorf("Expected the common prefix to be completed, got %q", line)
+	}
+	if !reflect.DeepEqual(gotWords, []string{"actor", "Tom Hanks"}) {
+		t.Errorf("Expected the words before the cursor, got %q", gotWords)
+	}
+
+	e.Update(tui.Key{Name: tui.KeyTab})
+	if !reflect.DeepEqual(e.Candidates, []string{"--min-rating", "--min-votes"}) {
+		t.Errorf("Expected the candidates to be listed, got %q", e.Candidates)
+	}
+
+	typeText(e, "v")
+	e.Update(tui.Key{Name: tui.KeyTab})
+	if line := e.Line(); line != `actor "Tom Hanks" --min-votes ` {
+		t.Errorf("Expected a unique candidate to be completed with a space, got %q", line)
+	}
+}